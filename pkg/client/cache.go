@@ -0,0 +1,242 @@
+package client
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores response entries for WithCache. Implementations must be safe for
+// concurrent use and must not return entries whose ttl has elapsed.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// responseCache holds the configuration installed by WithCache.
+type responseCache struct {
+	store Cache
+	ttl   time.Duration
+}
+
+// WithCache serves repeated GET requests from store. Successful (200) responses
+// are stored under their method, URL, Accept header, and a hash of the API key
+// they were fetched with, so callers with different keys or formats never share
+// entries. Entries are kept for the duration given by the response's
+// Cache-Control or Expires headers (see CacheTTL), or for ttl when the response
+// carries neither. A ttl of zero therefore caches only responses whose headers
+// allow it. On a hit DoRequest returns a synthetic 200 response reading the
+// cached body, with the original Content-Type, without contacting the API;
+// FromCache reports such responses. A nil store disables caching.
+func WithCache(store Cache, ttl time.Duration) Option {
+	return func(c *Client) {
+		if store == nil {
+			c.cache = nil
+			return
+		}
+		c.cache = &responseCache{store: store, ttl: max(ttl, 0)}
+	}
+}
+
+// fromCacheKey is the context key marking the request of a response served from
+// the WithCache store.
+type fromCacheKey struct{}
+
+// FromCache reports whether resp was served from the WithCache store rather
+// than fetched from the API, for example to count cache hits. The mark is kept
+// on resp.Request's context, so it cannot collide with a response header.
+func FromCache(resp *http.Response) bool {
+	return resp != nil && resp.Request != nil && resp.Request.Context().Value(fromCacheKey{}) != nil
+}
+
+// cacheKey returns the key req is cached under and whether it is cacheable. It
+// must be called after the apikey header is set.
+func cacheKey(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet {
+		return "", false
+	}
+	keyHash := sha256.Sum256([]byte(req.Header.Get("apikey")))
+	return fmt.Sprintf("%s %s accept=%q key=%x", req.Method, req.URL.String(), req.Header.Get("Accept"), keyHash[:8]), true
+}
+
+// encodeCacheEntry prefixes body with the response Content-Type so a cache hit
+// can replay it.
+func encodeCacheEntry(contentType string, body []byte) []byte {
+	entry := make([]byte, 0, len(contentType)+1+len(body))
+	entry = append(entry, contentType...)
+	entry = append(entry, '\n')
+	return append(entry, body...)
+}
+
+// decodeCacheEntry splits an entry written by encodeCacheEntry.
+func decodeCacheEntry(entry []byte) (contentType string, body []byte) {
+	if i := bytes.IndexByte(entry, '\n'); i >= 0 {
+		return string(entry[:i]), entry[i+1:]
+	}
+	return "", entry
+}
+
+// cachedResponse builds the response returned for a cache hit.
+func cachedResponse(req *http.Request, entry []byte) *http.Response {
+	contentType, body := decodeCacheEntry(entry)
+	header := make(http.Header)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req.WithContext(context.WithValue(req.Context(), fromCacheKey{}, true)),
+	}
+}
+
+// save stores the body of a successful response under key and replaces the
+// response body with an in-memory copy.
+func (rc *responseCache) save(key string, resp *http.Response) (*http.Response, error) {
+	if resp.StatusCode != http.StatusOK || resp.Body == nil {
+		return resp, nil
+	}
+	ttl := CacheTTL(resp.Header, rc.ttl, time.Now())
+	if ttl <= 0 {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		err = errors.Join(err, closeErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body for caching: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	rc.store.Set(key, encodeCacheEntry(resp.Header.Get("Content-Type"), body), ttl)
+	return resp, nil
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used entry once
+// it holds capacity entries. Expired entries are dropped when looked up.
+type LRUCache struct {
+	now      func() time.Time
+	entries  map[string]*list.Element
+	order    *list.List
+	mu       sync.Mutex
+	capacity int
+}
+
+// lruEntry is an LRUCache element.
+type lruEntry struct {
+	expires time.Time
+	key     string
+	body    []byte
+}
+
+// NewLRUCache returns an LRUCache holding at most capacity entries. A capacity
+// below 1 is treated as 1.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		now:      time.Now,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		capacity: max(capacity, 1),
+	}
+}
+
+// Get returns a copy of the body stored under key if it has not expired.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if !l.now().Before(entry.expires) {
+		l.order.Remove(el)
+		delete(l.entries, key)
+		return nil, false
+	}
+	l.order.MoveToFront(el)
+	return bytes.Clone(entry.body), true
+}
+
+// Set stores a copy of body under key for ttl. A non-positive ttl is ignored.
+func (l *LRUCache) Set(key string, body []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := &lruEntry{key: key, body: bytes.Clone(body), expires: l.now().Add(ttl)}
+	if el, ok := l.entries[key]; ok {
+		el.Value = entry
+		l.order.MoveToFront(el)
+		return
+	}
+	l.entries[key] = l.order.PushFront(entry)
+	for l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of entries held, including expired ones not yet evicted.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
+
+// CacheTTL derives how long a response may be cached from its Cache-Control and
+// Expires headers, falling back to fallback when neither header applies.
+//
+// Cache-Control no-store and no-cache yield zero. A max-age directive takes
+// precedence over Expires and is reduced by the Age header when present. An
+// Expires date is measured from now; dates in the past and malformed values
+// yield zero, as the header then marks the response as already stale.
+func CacheTTL(h http.Header, fallback time.Duration, now time.Time) time.Duration {
+	if cc := h.Get("Cache-Control"); cc != "" {
+		maxAge, hasMaxAge := time.Duration(0), false
+		for _, directive := range strings.Split(cc, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "no-store", "no-cache":
+				return 0
+			case "max-age":
+				secs, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(value), `"`), 10, 64)
+				if err != nil || secs < 0 {
+					return 0
+				}
+				maxAge, hasMaxAge = time.Duration(secs)*time.Second, true
+			}
+		}
+		if hasMaxAge {
+			if age, err := strconv.ParseInt(strings.TrimSpace(h.Get("Age")), 10, 64); err == nil && age > 0 {
+				maxAge -= time.Duration(age) * time.Second
+			}
+			return max(maxAge, 0)
+		}
+	}
+	if expires := h.Get("Expires"); expires != "" {
+		at, err := http.ParseTime(expires)
+		if err != nil {
+			return 0
+		}
+		return max(at.Sub(now), 0)
+	}
+	return fallback
+}
@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheTTL(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+	const fallback = 10 * time.Minute
+
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   time.Duration
+	}{
+		{name: "no headers", want: fallback},
+		{name: "max-age", header: map[string]string{"Cache-Control": "public, max-age=300"}, want: 5 * time.Minute},
+		{name: "max-age minus age", header: map[string]string{"Cache-Control": "max-age=300", "Age": "60"}, want: 4 * time.Minute},
+		{name: "age beyond max-age", header: map[string]string{"Cache-Control": "max-age=30", "Age": "60"}, want: 0},
+		{name: "max-age wins over expires", header: map[string]string{"Cache-Control": "max-age=60", "Expires": "Mon, 03 Nov 2025 13:00:00 GMT"}, want: time.Minute},
+		{name: "no-store", header: map[string]string{"Cache-Control": "no-store"}, want: 0},
+		{name: "no-cache", header: map[string]string{"Cache-Control": "No-Cache, max-age=300"}, want: 0},
+		{name: "malformed max-age", header: map[string]string{"Cache-Control": "max-age=soon"}, want: 0},
+		{name: "unrelated directive", header: map[string]string{"Cache-Control": "public"}, want: fallback},
+		{name: "expires", header: map[string]string{"Expires": "Mon, 03 Nov 2025 12:30:00 GMT"}, want: 30 * time.Minute},
+		{name: "expires in past", header: map[string]string{"Expires": "Mon, 03 Nov 2025 11:00:00 GMT"}, want: 0},
+		{name: "malformed expires", header: map[string]string{"Expires": "0"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := make(http.Header)
+			for k, v := range tt.header {
+				h.Set(k, v)
+			}
+			if got := CacheTTL(h, fallback, now); got != tt.want {
+				t.Errorf("CacheTTL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// cacheHTTPClient numbers each response body and returns the given status
+// and headers.
+type cacheHTTPClient struct {
+	header http.Header
+	status int
+	calls  int
+}
+
+func (m *cacheHTTPClient) Do(_ *http.Request) (*http.Response, error) {
+	m.calls++
+	status := m.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     m.header.Clone(),
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"call":%d}`, m.calls))),
+	}, nil
+}
+
+func TestWithCache(t *testing.T) {
+	get := func(t *testing.T, c *Client, method, endpoint string) string {
+		t.Helper()
+		req, err := c.NewRequest(context.Background(), method, endpoint, nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		resp, err := c.DoRequest(req)
+		if err != nil {
+			t.Fatalf("DoRequest: %v", err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if err := resp.Body.Close(); err != nil {
+			t.Fatalf("close body: %v", err)
+		}
+		return string(body)
+	}
+
+	t.Run("hit and miss", func(t *testing.T) {
+		mock := &cacheHTTPClient{}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute))
+		if got := get(t, c, http.MethodGet, "states"); got != `{"call":1}` {
+			t.Fatalf("first call body = %s", got)
+		}
+		if got := get(t, c, http.MethodGet, "states"); got != `{"call":1}` {
+			t.Errorf("expected cached body, got %s", got)
+		}
+		if got := get(t, c, http.MethodGet, "counties"); got != `{"call":2}` {
+			t.Errorf("expected miss for a different URL, got %s", got)
+		}
+		if mock.calls != 2 {
+			t.Errorf("expected 2 upstream calls, got %d", mock.calls)
+		}
+	})
+
+	t.Run("only GET is cached", func(t *testing.T) {
+		mock := &cacheHTTPClient{}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute))
+		get(t, c, http.MethodPost, "search")
+		get(t, c, http.MethodPost, "search")
+		if mock.calls != 2 {
+			t.Errorf("expected POST to bypass the cache, got %d calls", mock.calls)
+		}
+	})
+
+	t.Run("non-200 is not cached", func(t *testing.T) {
+		mock := &cacheHTTPClient{status: http.StatusNotFound}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute))
+		get(t, c, http.MethodGet, "states")
+		get(t, c, http.MethodGet, "states")
+		if mock.calls != 2 {
+			t.Errorf("expected 2 upstream calls, got %d", mock.calls)
+		}
+	})
+
+	t.Run("no-store is not cached", func(t *testing.T) {
+		mock := &cacheHTTPClient{header: http.Header{"Cache-Control": {"no-store"}}}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute))
+		get(t, c, http.MethodGet, "states")
+		get(t, c, http.MethodGet, "states")
+		if mock.calls != 2 {
+			t.Errorf("expected 2 upstream calls, got %d", mock.calls)
+		}
+	})
+
+	t.Run("keyed by API key and Accept", func(t *testing.T) {
+		mock := &cacheHTTPClient{}
+		extract := func(ctx context.Context) string {
+			key, _ := ctx.Value(tenantKey{}).(string)
+			return key
+		}
+		c := New("", mock, WithCache(NewLRUCache(10), time.Minute), WithAPIKeyFromContext(extract))
+		fetch := func(tenant, accept string) string {
+			t.Helper()
+			ctx := context.WithValue(context.Background(), tenantKey{}, tenant)
+			req, err := c.NewRequest(ctx, http.MethodGet, "states", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			if accept != "" {
+				req.Header.Set("Accept", accept)
+			}
+			resp, err := c.DoRequest(req)
+			if err != nil {
+				t.Fatalf("DoRequest: %v", err)
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if err := resp.Body.Close(); err != nil {
+				t.Fatalf("close body: %v", err)
+			}
+			return string(body)
+		}
+		if got := fetch("tenant-a", ""); got != `{"call":1}` {
+			t.Fatalf("tenant A body = %s", got)
+		}
+		if got := fetch("tenant-b", ""); got != `{"call":2}` {
+			t.Errorf("expected tenant B to miss tenant A's entry, got %s", got)
+		}
+		if got := fetch("tenant-a", "application/xml"); got != `{"call":3}` {
+			t.Errorf("expected a different Accept to miss, got %s", got)
+		}
+		if got := fetch("tenant-a", ""); got != `{"call":1}` {
+			t.Errorf("expected tenant A hit, got %s", got)
+		}
+	})
+
+	t.Run("hit replays Content-Type", func(t *testing.T) {
+		mock := &cacheHTTPClient{header: http.Header{"Content-Type": {"application/xml"}}}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute))
+		for i := 0; i < 2; i++ {
+			req, err := c.NewRequest(context.Background(), http.MethodGet, "states", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			resp, err := c.DoRequest(req)
+			if err != nil {
+				t.Fatalf("DoRequest: %v", err)
+			}
+			if err := resp.Body.Close(); err != nil {
+				t.Fatalf("close body: %v", err)
+			}
+			if got := resp.Header.Get("Content-Type"); got != "application/xml" {
+				t.Errorf("call %d: expected application/xml, got %q", i+1, got)
+			}
+		}
+		if mock.calls != 1 {
+			t.Errorf("expected 1 upstream call, got %d", mock.calls)
+		}
+	})
+
+	t.Run("FromCache", func(t *testing.T) {
+		// An upstream X-From-Cache header must not be mistaken for a cache hit.
+		mock := &cacheHTTPClient{header: http.Header{"X-From-Cache": {"1"}}}
+		c := New("key", mock, WithCache(NewLRUCache(10), time.Minute), WithDefaultTimeout(time.Minute))
+		for i, want := range []bool{false, true} {
+			req, err := c.NewRequest(context.Background(), http.MethodGet, "states", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			resp, err := c.DoRequest(req)
+			if err != nil {
+				t.Fatalf("DoRequest: %v", err)
+			}
+			if err := resp.Body.Close(); err != nil {
+				t.Fatalf("close body: %v", err)
+			}
+			if got := FromCache(resp); got != want {
+				t.Errorf("call %d: FromCache = %v, want %v", i+1, got, want)
+			}
+		}
+		if FromCache(nil) {
+			t.Error("expected FromCache(nil) to be false")
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+		store := NewLRUCache(10)
+		store.now = func() time.Time { return now }
+		mock := &cacheHTTPClient{}
+		c := New("key", mock, WithCache(store, time.Minute))
+		get(t, c, http.MethodGet, "states")
+		now = now.Add(59 * time.Second)
+		if got := get(t, c, http.MethodGet, "states"); got != `{"call":1}` {
+			t.Errorf("expected cached body before expiry, got %s", got)
+		}
+		now = now.Add(time.Second)
+		if got := get(t, c, http.MethodGet, "states"); got != `{"call":2}` {
+			t.Errorf("expected refetch after expiry, got %s", got)
+		}
+	})
+}
+
+func TestLRUCache(t *testing.T) {
+	l := NewLRUCache(2)
+	l.Set("a", []byte("1"), time.Minute)
+	l.Set("b", []byte("2"), time.Minute)
+	if _, ok := l.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	l.Set("c", []byte("3"), time.Minute)
+	if _, ok := l.Get("b"); ok {
+		t.Error("expected least recently used entry b to be evicted")
+	}
+	if got, ok := l.Get("a"); !ok || string(got) != "1" {
+		t.Errorf("Get(a) = %q, %v", got, ok)
+	}
+	got, _ := l.Get("c")
+	got[0] = 'x'
+	if again, _ := l.Get("c"); string(again) != "3" {
+		t.Errorf("expected Get to return a copy, cache now holds %q", again)
+	}
+	l.Set("d", []byte("4"), 0)
+	if _, ok := l.Get("d"); ok || l.Len() != 2 {
+		t.Errorf("expected non-positive ttl to be ignored, len=%d", l.Len())
+	}
+}
@@ -5,9 +5,11 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -22,25 +24,174 @@ type HTTPClient interface {
 // It handles authentication and request execution.
 type Client struct {
 	httpClient HTTPClient
+	baseURLErr error
+	abortCodes map[int]struct{}
+	headers    http.Header
+	retry      *retryPolicy
+	cache      *responseCache
+	recorder   *trafficRecorder
+	observer   Observer
+	keyFromCtx func(context.Context) string
 	apiKey     string
 	baseURL    string
+	userAgent  string
+
+	requestTimeout     time.Duration
+	disableCompression bool
 }
 
 // Option represents a functional configuration option for Client.
 type Option func(*Client)
 
+// defaultTimeout is the timeout of the *http.Client created when none is supplied.
+const defaultTimeout = 30 * time.Second
+
 // DefaultBaseURL is the default root ATTOM API URL used when no override is supplied.
 const DefaultBaseURL = "https://api.gateway.attomdata.com/"
 
+// Version is the go-attom library version reported in the default User-Agent.
+const Version = "0.1.0"
+
+// DefaultUserAgent is the User-Agent sent when WithUserAgent is not supplied.
+const DefaultUserAgent = "go-attom/" + Version
+
+// WithUserAgent sets the User-Agent header sent with every request, for example to
+// distinguish environments in ATTOM's logs. An empty string keeps DefaultUserAgent.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		if ua = strings.TrimSpace(ua); ua != "" {
+			c.userAgent = ua
+		}
+	}
+}
+
 // WithBaseURL sets a custom base URL for the API client. Trailing slashes are normalized.
 // If an empty string is provided, the option is ignored and DefaultBaseURL remains.
+// A URL without an http or https scheme and a host is rejected: the base URL is
+// left unchanged and NewRequest returns an error wrapping ErrInvalidBaseURL.
 func WithBaseURL(baseURL string) Option {
 	return func(c *Client) {
 		if baseURL == "" {
 			return
 		}
-		normalized := strings.TrimRight(baseURL, "/") + "/"
+		normalized, err := normalizeBaseURL(baseURL)
+		if err != nil {
+			c.baseURLErr = err
+			return
+		}
 		c.baseURL = normalized
+		c.baseURLErr = nil
+	}
+}
+
+// SetBaseURL replaces the client's base URL after validating that it has an http
+// or https scheme and a host. On error the existing base URL is kept.
+func (c *Client) SetBaseURL(baseURL string) error {
+	normalized, err := normalizeBaseURL(baseURL)
+	if err != nil {
+		return err
+	}
+	c.baseURL = normalized
+	c.baseURLErr = nil
+	return nil
+}
+
+// normalizeBaseURL validates raw as an absolute http(s) URL and ensures a single
+// trailing slash.
+func normalizeBaseURL(raw string) (string, error) {
+	trimmed := strings.TrimSpace(raw)
+	u, err := url.Parse(trimmed)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrInvalidBaseURL, raw, err)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+	case "":
+		return "", fmt.Errorf("%w %q: missing scheme, expected http:// or https://", ErrInvalidBaseURL, raw)
+	default:
+		return "", fmt.Errorf("%w %q: unsupported scheme %q, expected http or https", ErrInvalidBaseURL, raw, u.Scheme)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%w %q: missing host", ErrInvalidBaseURL, raw)
+	}
+	return strings.TrimRight(trimmed, "/") + "/", nil
+}
+
+// WithRoundTripper routes requests through rt, for example an OpenTelemetry
+// transport wrapping http.DefaultTransport. The apikey and other managed headers
+// are set before rt runs, so instrumentation observes the final request. When the
+// client's HTTPClient is an *http.Client, a copy with rt as its Transport is used
+// and its other settings, such as Timeout, are kept; any other HTTPClient is
+// replaced by an *http.Client using rt with the default 30s timeout.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		if rt == nil {
+			return
+		}
+		if hc, ok := c.httpClient.(*http.Client); ok && hc != nil {
+			wrapped := *hc
+			wrapped.Transport = rt
+			c.httpClient = &wrapped
+			return
+		}
+		c.httpClient = &http.Client{Transport: rt, Timeout: defaultTimeout}
+	}
+}
+
+// WithAPIKeyFromContext configures a function that extracts a per-request API key
+// from the request context, for gateways serving several tenants. DoRequest uses
+// the extracted key when it is non-empty and otherwise falls back to the key
+// passed to New. Like the static key, it is redacted from recorded traffic.
+func WithAPIKeyFromContext(fn func(ctx context.Context) string) Option {
+	return func(c *Client) {
+		c.keyFromCtx = fn
+	}
+}
+
+// WithDefaultHeaders adds headers to every request built by NewRequest, for
+// example environment or correlation headers required by a gateway. Repeated use
+// merges the headers. The headers managed by the client itself (apikey, Accept,
+// and Content-Type) cannot be overridden this way.
+func WithDefaultHeaders(h http.Header) Option {
+	return func(c *Client) {
+		if len(h) == 0 {
+			return
+		}
+		if c.headers == nil {
+			c.headers = make(http.Header, len(h))
+		}
+		for key, values := range h {
+			key = http.CanonicalHeaderKey(key)
+			if _, managed := managedHeaders[key]; managed {
+				continue
+			}
+			c.headers[key] = append([]string(nil), values...)
+		}
+	}
+}
+
+// managedHeaders are set by the client and never taken from WithDefaultHeaders.
+var managedHeaders = map[string]struct{}{
+	"Apikey":       {},
+	"Accept":       {},
+	"Content-Type": {},
+}
+
+// WithAbortOnStatusCodes marks HTTP status codes that must terminate a request
+// immediately. When a response carries one of these codes, DoRequest closes the
+// body and returns an *AbortError instead of the response; such errors are never
+// retried and can be detected with errors.Is(err, ErrAborted).
+func WithAbortOnStatusCodes(codes ...int) Option {
+	return func(c *Client) {
+		if len(codes) == 0 {
+			return
+		}
+		if c.abortCodes == nil {
+			c.abortCodes = make(map[int]struct{}, len(codes))
+		}
+		for _, code := range codes {
+			c.abortCodes[code] = struct{}{}
+		}
 	}
 }
 
@@ -50,12 +201,13 @@ func WithBaseURL(baseURL string) Option {
 // The apiKey must be a valid ATTOM API key.
 func New(apiKey string, httpClient HTTPClient, opts ...Option) *Client {
 	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+		httpClient = &http.Client{Timeout: defaultTimeout}
 	}
 	c := &Client{
 		httpClient: httpClient,
 		apiKey:     apiKey,
 		baseURL:    DefaultBaseURL,
+		userAgent:  DefaultUserAgent,
 	}
 	for _, opt := range opts {
 		if opt != nil {
@@ -65,24 +217,159 @@ func New(apiKey string, httpClient HTTPClient, opts ...Option) *Client {
 	return c
 }
 
+// Clone returns a copy of c with opts applied on top of its configuration, for
+// example to use a different base URL for a single workflow. The original client
+// is left untouched: default headers, abort codes, and the retry policy are
+// deep-copied. The HTTPClient, cache, traffic recorder, and observer are shared.
+func (c *Client) Clone(opts ...Option) *Client {
+	clone := *c
+	clone.headers = c.headers.Clone()
+	if c.abortCodes != nil {
+		clone.abortCodes = make(map[int]struct{}, len(c.abortCodes))
+		for code := range c.abortCodes {
+			clone.abortCodes[code] = struct{}{}
+		}
+	}
+	if c.retry != nil {
+		retry := *c.retry
+		retry.statusCodes = make(map[int]struct{}, len(c.retry.statusCodes))
+		for code := range c.retry.statusCodes {
+			retry.statusCodes[code] = struct{}{}
+		}
+		clone.retry = &retry
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&clone)
+		}
+	}
+	return &clone
+}
+
 // ErrInvalidAPIKey is returned when the API key is missing or invalid.
 var ErrInvalidAPIKey = errors.New("invalid or missing API key")
 
+// ErrInvalidBaseURL is returned when a base URL lacks an http(s) scheme or a host.
+var ErrInvalidBaseURL = errors.New("invalid base URL")
+
+// ErrAborted is matched by errors returned for status codes configured with
+// WithAbortOnStatusCodes.
+var ErrAborted = errors.New("request aborted on terminal status code")
+
+// AbortError is a terminal, non-retryable error produced when a response status
+// code was registered with WithAbortOnStatusCodes.
+type AbortError struct {
+	StatusCode int
+}
+
+// Error implements the error interface.
+func (e *AbortError) Error() string {
+	return fmt.Sprintf("%s: http status %d", ErrAborted.Error(), e.StatusCode)
+}
+
+// Is reports whether target is ErrAborted.
+func (e *AbortError) Is(target error) bool {
+	return target == ErrAborted
+}
+
 // DoRequest executes an HTTP request with the API key injected.
 //
-// The req must be non-nil and will have the API key added as a header.
-// Returns an error with context if the request fails.
+// The req must be non-nil and will have the API key added as a header, taken
+// from the context when WithAPIKeyFromContext supplies one, along with the
+// configured User-Agent unless the request already carries one. Returns an
+// error with context if the request fails. When WithRetry is configured,
+// idempotent requests are retried as described there; when WithCache is
+// configured, cached GET responses are returned without contacting the API.
+// When WithDefaultTimeout is configured and the request context has no deadline,
+// the request is bounded by the default timeout until its body is closed.
 func (c *Client) DoRequest(req *http.Request) (*http.Response, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request cannot be nil")
 	}
-	if c.apiKey == "" {
+	req, cancel := c.withDefaultTimeout(req)
+	if cancel == nil {
+		return c.dispatch(req)
+	}
+	resp, err := c.dispatch(req)
+	return cancelOnClose(resp, err, cancel)
+}
+
+// dispatch authenticates req and sends it through the cache and retry layers.
+func (c *Client) dispatch(req *http.Request) (*http.Response, error) {
+	apiKey := c.apiKey
+	if c.keyFromCtx != nil {
+		if key := c.keyFromCtx(req.Context()); key != "" {
+			apiKey = key
+		}
+	}
+	if apiKey == "" {
 		return nil, ErrInvalidAPIKey
 	}
-	req.Header.Set("apikey", c.apiKey)
+	req.Header.Set("apikey", apiKey)
+	if c.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	applyIdempotencyKey(req)
+	if c.cache == nil {
+		return c.send(req)
+	}
+	key, cacheable := cacheKey(req)
+	if !cacheable {
+		return c.send(req)
+	}
+	if body, ok := c.cache.store.Get(key); ok {
+		return cachedResponse(req, body), nil
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.cache.save(key, resp)
+}
+
+// send performs req, retrying it when WithRetry applies.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.retry == nil || !retryableRequest(req) {
+		return c.do(req)
+	}
+	return c.retry.run(req, c.do)
+}
+
+// do performs a single attempt of req.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
+	if err == nil && !c.disableCompression {
+		if zerr := decompress(resp); zerr != nil {
+			resp, err = nil, zerr
+		}
+	}
+	if c.observer != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.observer.ObserveRequest(EndpointLabel(req.URL.Path), status, time.Since(start))
+	}
+	if c.recorder != nil {
+		if recErr := c.recorder.record(req, resp, err); recErr != nil && err == nil {
+			return nil, recErr
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
+	if _, abort := c.abortCodes[resp.StatusCode]; abort {
+		abortErr := &AbortError{StatusCode: resp.StatusCode}
+		if resp.Body != nil {
+			if closeErr := resp.Body.Close(); closeErr != nil {
+				return nil, errors.Join(abortErr, fmt.Errorf("failed to close response body: %w", closeErr))
+			}
+		}
+		return nil, abortErr
+	}
+	if resp.Body != nil {
+		resp.Body = &readPhaseBody{ReadCloser: resp.Body}
+	}
 	return resp, nil
 }
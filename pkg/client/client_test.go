@@ -1,12 +1,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 )
 
 const testContentTypeJSON = "application/json"
@@ -65,6 +68,137 @@ func TestDoRequest_APIKeyInjection(t *testing.T) {
 	}
 }
 
+type tenantKey struct{}
+
+func TestWithAPIKeyFromContext(t *testing.T) {
+	extract := func(ctx context.Context) string {
+		key, _ := ctx.Value(tenantKey{}).(string)
+		return key
+	}
+	tenantCtx := context.WithValue(context.Background(), tenantKey{}, "tenant-key")
+
+	tests := []struct {
+		name      string
+		staticKey string
+		ctx       context.Context
+		wantKey   string
+		wantErr   error
+	}{
+		{name: "context key present", staticKey: "static-key", ctx: tenantCtx, wantKey: "tenant-key"},
+		{name: "context key absent", staticKey: "static-key", ctx: context.Background(), wantKey: "static-key"},
+		{name: "context key without static key", ctx: tenantCtx, wantKey: "tenant-key"},
+		{name: "no key anywhere", ctx: context.Background(), wantErr: ErrInvalidAPIKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &headerCheckHTTPClient{t: t, wantKey: tt.wantKey}
+			c := New(tt.staticKey, mock, WithAPIKeyFromContext(extract))
+			req, err := c.NewRequest(tt.ctx, http.MethodGet, "endpoint", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+			_, err = c.DoRequest(req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !mock.called {
+				t.Error("expected mock Do to be called")
+			}
+		})
+	}
+
+	t.Run("context key is redacted from recordings", func(t *testing.T) {
+		var buf bytes.Buffer
+		mock := &staticHTTPClient{statusCode: http.StatusOK, body: "{}"}
+		c := New("static-key", mock, WithAPIKeyFromContext(extract), WithTrafficRecorder(&buf))
+		req, err := c.NewRequest(tenantCtx, http.MethodGet, "endpoint", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Contains(buf.String(), "tenant-key") {
+			t.Errorf("recorded exchange leaked the context API key: %s", buf.String())
+		}
+	})
+}
+
+// spanRecorder is a fake instrumentation transport that records the fields a
+// tracing span would capture.
+type spanRecorder struct {
+	method     string
+	host       string
+	path       string
+	apiKey     string
+	userAgent  string
+	statusCode int
+}
+
+func (r *spanRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.method = req.Method
+	r.host = req.URL.Host
+	r.path = req.URL.Path
+	r.apiKey = req.Header.Get("apikey")
+	r.userAgent = req.Header.Get("User-Agent")
+	resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Header: make(http.Header), Request: req}
+	r.statusCode = resp.StatusCode
+	return resp, nil
+}
+
+func TestWithRoundTripper(t *testing.T) {
+	rt := &spanRecorder{}
+	c := New("my-key", nil, WithBaseURL("https://example.com/"), WithRoundTripper(rt))
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v4/property/detail", url.Values{"attomid": {"1"}}, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	resp, err := c.DoRequest(req)
+	if err != nil {
+		t.Fatalf("DoRequest returned error: %v", err)
+	}
+	if err := resp.Body.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if rt.method != http.MethodGet || rt.host != "example.com" || rt.path != "/v4/property/detail" {
+		t.Errorf("unexpected span target: %s %s%s", rt.method, rt.host, rt.path)
+	}
+	if rt.apiKey != "my-key" {
+		t.Errorf("apikey must be injected before the round tripper runs, got %q", rt.apiKey)
+	}
+	if rt.userAgent != DefaultUserAgent {
+		t.Errorf("User-Agent = %q, want %q", rt.userAgent, DefaultUserAgent)
+	}
+	if rt.statusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", rt.statusCode)
+	}
+
+	hc, ok := c.httpClient.(*http.Client)
+	if !ok || hc.Timeout != defaultTimeout {
+		t.Errorf("expected *http.Client with default timeout, got %#v", c.httpClient)
+	}
+
+	t.Run("keeps settings of a supplied http.Client", func(t *testing.T) {
+		base := &http.Client{Timeout: 5 * time.Second}
+		c := New("my-key", base, WithRoundTripper(rt))
+		hc, ok := c.httpClient.(*http.Client)
+		if !ok || hc.Timeout != 5*time.Second || hc.Transport != rt {
+			t.Errorf("expected wrapped client with 5s timeout, got %#v", c.httpClient)
+		}
+		if base.Transport != nil {
+			t.Error("supplied http.Client must not be mutated")
+		}
+	})
+}
+
 func TestDoRequest_Errors(t *testing.T) {
 	c := New("", &mockHTTPClient{})
 	req, err := http.NewRequest("GET", "http://example.com", nil)
@@ -122,6 +256,185 @@ func TestWithBaseURL_Option(t *testing.T) {
 	}
 }
 
+func TestClone(t *testing.T) {
+	parent := New("key", nil,
+		WithBaseURL("https://parent.example.com"),
+		WithDefaultHeaders(http.Header{"X-Env": {"prod"}}),
+		WithAbortOnStatusCodes(http.StatusUnauthorized),
+		WithRetry(3, time.Millisecond),
+	)
+	clone := parent.Clone(
+		WithBaseURL("https://clone.example.com"),
+		WithDefaultHeaders(http.Header{"X-Env": {"staging"}}),
+		WithAbortOnStatusCodes(http.StatusForbidden),
+		WithRetry(5, time.Second),
+	)
+
+	if parent.baseURL != "https://parent.example.com/" {
+		t.Errorf("parent baseURL = %q", parent.baseURL)
+	}
+	if clone.baseURL != "https://clone.example.com/" {
+		t.Errorf("clone baseURL = %q", clone.baseURL)
+	}
+	if got := parent.headers.Get("X-Env"); got != "prod" {
+		t.Errorf("parent header = %q, want prod", got)
+	}
+	if got := clone.headers.Get("X-Env"); got != "staging" {
+		t.Errorf("clone header = %q, want staging", got)
+	}
+	if _, ok := parent.abortCodes[http.StatusForbidden]; ok {
+		t.Error("clone abort codes leaked into parent")
+	}
+	if _, ok := clone.abortCodes[http.StatusUnauthorized]; !ok {
+		t.Error("clone lost inherited abort code")
+	}
+	if parent.retry.maxAttempts != 3 || clone.retry.maxAttempts != 5 {
+		t.Errorf("retry attempts parent=%d clone=%d", parent.retry.maxAttempts, clone.retry.maxAttempts)
+	}
+	if clone.apiKey != "key" || clone.httpClient != parent.httpClient {
+		t.Error("clone did not inherit credentials and HTTP client")
+	}
+}
+
+func TestWithBaseURL_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{name: "missing scheme", baseURL: "api.example.com", want: "missing scheme"},
+		{name: "missing scheme with path", baseURL: "api.example.com/v1", want: "missing scheme"},
+		{name: "unsupported scheme", baseURL: "ftp://api.example.com", want: "unsupported scheme"},
+		{name: "missing host", baseURL: "https://", want: "missing host"},
+		{name: "missing host with path", baseURL: "https:///v1", want: "missing host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New("key", nil, WithBaseURL(tt.baseURL))
+			if c.baseURL != DefaultBaseURL {
+				t.Errorf("baseURL = %q, want unchanged %q", c.baseURL, DefaultBaseURL)
+			}
+			_, err := c.NewRequest(context.Background(), http.MethodGet, "endpoint", nil, nil)
+			if !errors.Is(err, ErrInvalidBaseURL) {
+				t.Fatalf("expected ErrInvalidBaseURL, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+
+			if err := c.SetBaseURL(tt.baseURL); !errors.Is(err, ErrInvalidBaseURL) {
+				t.Errorf("SetBaseURL: expected ErrInvalidBaseURL, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSetBaseURL(t *testing.T) {
+	c := New("key", nil, WithBaseURL("api.example.com"))
+	if err := c.SetBaseURL("HTTPS://api.example.com/v2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.baseURL != "HTTPS://api.example.com/v2/" {
+		t.Errorf("baseURL = %q, want normalized URL", c.baseURL)
+	}
+	if _, err := c.NewRequest(context.Background(), http.MethodGet, "endpoint", nil, nil); err != nil {
+		t.Errorf("expected earlier option error to be cleared, got %v", err)
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default", func(t *testing.T) {
+		req, err := New("key", nil).NewRequest(ctx, http.MethodGet, "endpoint", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if got := req.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		c := New("key", nil, WithUserAgent("acme-etl/2.3 (staging)"))
+		req, err := c.NewRequest(ctx, http.MethodGet, "endpoint", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if got := req.Header.Get("User-Agent"); got != "acme-etl/2.3 (staging)" {
+			t.Errorf("User-Agent = %q, want custom value", got)
+		}
+	})
+
+	t.Run("empty keeps default", func(t *testing.T) {
+		c := New("key", nil, WithUserAgent(" "))
+		if c.userAgent != DefaultUserAgent {
+			t.Errorf("userAgent = %q, want %q", c.userAgent, DefaultUserAgent)
+		}
+	})
+
+	t.Run("caller value is preserved", func(t *testing.T) {
+		mock := &headerCheckHTTPClient{t: t, wantKey: "key"}
+		c := New("key", mock, WithUserAgent("acme-etl/2.3"))
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/endpoint", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		req.Header.Set("User-Agent", "caller/1.0")
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+		if got := req.Header.Get("User-Agent"); got != "caller/1.0" {
+			t.Errorf("User-Agent = %q, want caller value", got)
+		}
+	})
+}
+
+func TestWithDefaultHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Env", "staging")
+	h.Add("X-Correlation-Id", "abc")
+	h.Add("X-Correlation-Id", "def")
+	h.Set("Accept", "text/plain")
+	h.Set("Content-Type", "text/plain")
+	h.Set("apikey", "gateway-key")
+
+	mock := &headerCheckHTTPClient{t: t, wantKey: "key"}
+	c := New("key", mock, WithDefaultHeaders(h), WithDefaultHeaders(http.Header{"X-Team": {"data"}}))
+	h.Set("X-Env", "mutated")
+
+	req, err := c.NewRequest(context.Background(), http.MethodPost, "endpoint", nil, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if got := req.Header.Get("X-Env"); got != "staging" {
+		t.Errorf("X-Env = %q, want %q", got, "staging")
+	}
+	if got := req.Header.Values("X-Correlation-Id"); len(got) != 2 || got[0] != "abc" || got[1] != "def" {
+		t.Errorf("X-Correlation-Id = %v, want [abc def]", got)
+	}
+	if got := req.Header.Get("X-Team"); got != "data" {
+		t.Errorf("X-Team = %q, want %q", got, "data")
+	}
+	if got := req.Header.Get("Accept"); got != testContentTypeJSON {
+		t.Errorf("Accept = %q, want %q", got, testContentTypeJSON)
+	}
+	if got := req.Header.Get("Content-Type"); got != testContentTypeJSON {
+		t.Errorf("Content-Type = %q, want %q", got, testContentTypeJSON)
+	}
+	if got := req.Header.Get("apikey"); got != "" {
+		t.Errorf("apikey should not be copied from default headers, got %q", got)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		t.Fatalf("DoRequest returned error: %v", err)
+	}
+	if !mock.called {
+		t.Error("expected mock Do to be called")
+	}
+}
+
 func TestNewRequest(t *testing.T) {
 	c := New("key", nil)
 	ctx := context.Background()
@@ -182,6 +495,53 @@ func TestNewRequest_WithBody(t *testing.T) {
 	}
 }
 
+// onceReader is a reader that net/http cannot rewind on its own.
+type onceReader struct {
+	io.Reader
+	closed bool
+}
+
+func (r *onceReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestNewRequest_ReplayableBody(t *testing.T) {
+	c := New("key", nil)
+	const payload = `{"minComps":3}`
+	src := &onceReader{Reader: strings.NewReader(payload)}
+
+	req, err := c.NewRequest(context.Background(), http.MethodPost, "endpoint", nil, src)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if !src.closed {
+		t.Errorf("expected source body to be closed after buffering")
+	}
+	if req.GetBody == nil {
+		t.Fatalf("expected GetBody to be set")
+	}
+	if req.ContentLength != int64(len(payload)) {
+		t.Errorf("ContentLength = %d, want %d", req.ContentLength, len(payload))
+	}
+
+	first, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	again, err := req.GetBody()
+	if err != nil {
+		t.Fatalf("GetBody: %v", err)
+	}
+	second, err := io.ReadAll(again)
+	if err != nil {
+		t.Fatalf("read replayed body: %v", err)
+	}
+	if string(first) != payload || !bytes.Equal(first, second) {
+		t.Errorf("expected identical bodies, got %q and %q", first, second)
+	}
+}
+
 func TestNewRequest_PreservesExistingHeaders(t *testing.T) {
 	c := New("key", nil)
 	ctx := context.Background()
@@ -287,3 +647,62 @@ func TestNewRequest_NilQuery(t *testing.T) {
 		t.Errorf("expected empty query string, got %q", req.URL.RawQuery)
 	}
 }
+
+// countingHTTPClient returns a fixed status code and counts invocations.
+type countingHTTPClient struct {
+	statusCode int
+	calls      int
+}
+
+func (m *countingHTTPClient) Do(_ *http.Request) (*http.Response, error) {
+	m.calls++
+	return &http.Response{StatusCode: m.statusCode, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+func TestWithAbortOnStatusCodes(t *testing.T) {
+	t.Run("configured code aborts", func(t *testing.T) {
+		mock := &countingHTTPClient{statusCode: http.StatusUnauthorized}
+		c := New("key", mock, WithAbortOnStatusCodes(http.StatusUnauthorized, http.StatusForbidden))
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := c.DoRequest(req)
+		if resp != nil {
+			t.Errorf("expected nil response on abort")
+		}
+		if !errors.Is(err, ErrAborted) {
+			t.Fatalf("expected ErrAborted, got %v", err)
+		}
+		var abortErr *AbortError
+		if !errors.As(err, &abortErr) || abortErr.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected *AbortError with 401, got %v", err)
+		}
+		if mock.calls != 1 {
+			t.Errorf("expected exactly one attempt, got %d", mock.calls)
+		}
+	})
+
+	t.Run("other codes pass through", func(t *testing.T) {
+		mock := &countingHTTPClient{statusCode: http.StatusInternalServerError}
+		c := New("key", mock, WithAbortOnStatusCodes(http.StatusUnauthorized))
+		req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		resp, err := c.DoRequest(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected 500 response, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("no codes is a no-op", func(t *testing.T) {
+		c := New("key", nil, WithAbortOnStatusCodes())
+		if c.abortCodes != nil {
+			t.Errorf("expected no abort codes configured")
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WithCompression controls whether requests advertise Accept-Encoding: gzip and
+// gzip-encoded responses are decompressed transparently. It is enabled by default.
+func WithCompression(enabled bool) Option {
+	return func(c *Client) {
+		c.disableCompression = !enabled
+	}
+}
+
+// gzipBody decompresses a response body and closes the underlying stream.
+type gzipBody struct {
+	*gzip.Reader
+	raw io.Closer
+}
+
+// Close closes both the gzip reader and the underlying body.
+func (b *gzipBody) Close() error {
+	return errors.Join(b.Reader.Close(), b.raw.Close())
+}
+
+// decompress replaces a gzip-encoded response body with a decompressing reader
+// and removes the encoding headers, as net/http does for transparent gzip.
+func decompress(resp *http.Response) error {
+	if resp.Body == nil || !strings.EqualFold(strings.TrimSpace(resp.Header.Get("Content-Encoding")), "gzip") {
+		return nil
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			err = errors.Join(err, closeErr)
+		}
+		return fmt.Errorf("failed to decompress response: %w", err)
+	}
+	resp.Body = &gzipBody{Reader: zr, raw: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// gzipHTTPClient replies with a fixed body and Content-Encoding.
+type gzipHTTPClient struct {
+	encoding string
+	body     []byte
+	req      *http.Request
+}
+
+func (m *gzipHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	m.req = req
+	header := make(http.Header)
+	if m.encoding != "" {
+		header.Set("Content-Encoding", m.encoding)
+	}
+	header.Set("Content-Length", "123")
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(m.body)),
+	}, nil
+}
+
+func TestCompression(t *testing.T) {
+	const payload = `{"status":{"code":0}}`
+
+	send := func(t *testing.T, mock *gzipHTTPClient, opts ...Option) (*http.Response, string) {
+		t.Helper()
+		c := New("test-key", mock, opts...)
+		req, err := c.NewRequest(context.Background(), http.MethodGet, "propertyapi/v1.0.0/property/detail", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		resp, err := c.DoRequest(req)
+		if err != nil {
+			t.Fatalf("DoRequest: %v", err)
+		}
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if err := resp.Body.Close(); err != nil {
+			t.Fatalf("close body: %v", err)
+		}
+		return resp, string(data)
+	}
+
+	t.Run("decodes gzip by default", func(t *testing.T) {
+		mock := &gzipHTTPClient{encoding: "gzip", body: gzipBytes(t, payload)}
+		resp, body := send(t, mock)
+		if got := mock.req.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("expected Accept-Encoding gzip, got %q", got)
+		}
+		if body != payload {
+			t.Errorf("expected decoded body %q, got %q", payload, body)
+		}
+		if resp.Header.Get("Content-Encoding") != "" || resp.Header.Get("Content-Length") != "" {
+			t.Errorf("expected encoding headers removed, got %v", resp.Header)
+		}
+		if !resp.Uncompressed {
+			t.Error("expected Uncompressed to be set")
+		}
+	})
+
+	t.Run("plain response unchanged", func(t *testing.T) {
+		mock := &gzipHTTPClient{body: []byte(payload)}
+		_, body := send(t, mock)
+		if body != payload {
+			t.Errorf("expected body %q, got %q", payload, body)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		compressed := gzipBytes(t, payload)
+		mock := &gzipHTTPClient{encoding: "gzip", body: compressed}
+		resp, body := send(t, mock, WithCompression(false))
+		if got := mock.req.Header.Get("Accept-Encoding"); got != "" {
+			t.Errorf("expected no Accept-Encoding, got %q", got)
+		}
+		if body != string(compressed) || resp.Header.Get("Content-Encoding") != "gzip" {
+			t.Error("expected response to be passed through untouched")
+		}
+	})
+
+	t.Run("corrupt gzip", func(t *testing.T) {
+		mock := &gzipHTTPClient{encoding: "gzip", body: []byte("not gzip")}
+		c := New("test-key", mock)
+		req, err := c.NewRequest(context.Background(), http.MethodGet, "x", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		if _, err := c.DoRequest(req); err == nil {
+			t.Fatal("expected decompression error")
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// IdempotencyKeyHeader is the header carrying the key set with WithIdempotencyKey.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that makes DoRequest send key in the
+// Idempotency-Key header of POST requests, so the server can de-duplicate
+// repeated submissions. Because the server discards duplicates, WithRetry also
+// retries POST requests that carry a key and have a replayable body. An empty key
+// leaves ctx unchanged.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key = strings.TrimSpace(key); key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKey returns the key stored in ctx by WithIdempotencyKey.
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok
+}
+
+// applyIdempotencyKey sets the Idempotency-Key header on POST requests whose
+// context carries a key, unless the caller already set the header.
+func applyIdempotencyKey(req *http.Request) {
+	if req.Method != http.MethodPost || req.Header.Get(IdempotencyKeyHeader) != "" {
+		return
+	}
+	if key, ok := IdempotencyKey(req.Context()); ok {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+}
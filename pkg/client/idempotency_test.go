@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// recordingHTTPClient captures each request and replies with the scripted codes.
+type recordingHTTPClient struct {
+	codes    []int
+	requests []*http.Request
+	bodies   []string
+}
+
+func (m *recordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+	}
+	m.requests = append(m.requests, req)
+	m.bodies = append(m.bodies, body)
+	code := m.codes[min(len(m.requests), len(m.codes))-1]
+	return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader("")), Header: make(http.Header)}, nil
+}
+
+func TestWithIdempotencyKey(t *testing.T) {
+	ctx := WithIdempotencyKey(context.Background(), "search-42")
+
+	t.Run("sets header on POST", func(t *testing.T) {
+		mock := &recordingHTTPClient{codes: []int{http.StatusOK}}
+		c := New("key", mock)
+		req, err := c.NewRequest(ctx, http.MethodPost, "search", nil, strings.NewReader(`{"q":1}`))
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+		if got := mock.requests[0].Header.Get(IdempotencyKeyHeader); got != "search-42" {
+			t.Errorf("%s = %q, want %q", IdempotencyKeyHeader, got, "search-42")
+		}
+	})
+
+	t.Run("not set on GET", func(t *testing.T) {
+		mock := &recordingHTTPClient{codes: []int{http.StatusOK}}
+		c := New("key", mock)
+		req, err := c.NewRequest(ctx, http.MethodGet, "detail", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+		if got := mock.requests[0].Header.Get(IdempotencyKeyHeader); got != "" {
+			t.Errorf("expected no %s on GET, got %q", IdempotencyKeyHeader, got)
+		}
+	})
+
+	t.Run("empty key leaves context unchanged", func(t *testing.T) {
+		base := context.Background()
+		if WithIdempotencyKey(base, " ") != base {
+			t.Error("expected unchanged context for empty key")
+		}
+		if _, ok := IdempotencyKey(base); ok {
+			t.Error("expected no key on plain context")
+		}
+	})
+
+	t.Run("keyed POST is retried with the same body", func(t *testing.T) {
+		mock := &recordingHTTPClient{codes: []int{http.StatusServiceUnavailable, http.StatusOK}}
+		c := New("key", mock, WithRetry(3, time.Millisecond))
+		req, err := c.NewRequest(ctx, http.MethodPost, "search", nil, strings.NewReader(`{"q":1}`))
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		resp, err := c.DoRequest(req)
+		if err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || len(mock.requests) != 2 {
+			t.Fatalf("expected success after 2 attempts, got %d after %d", resp.StatusCode, len(mock.requests))
+		}
+		for i, body := range mock.bodies {
+			if body != `{"q":1}` {
+				t.Errorf("attempt %d body = %q, want original body", i+1, body)
+			}
+		}
+	})
+
+	t.Run("POST without key is not retried", func(t *testing.T) {
+		mock := &recordingHTTPClient{codes: []int{http.StatusServiceUnavailable, http.StatusOK}}
+		c := New("key", mock, WithRetry(3, time.Millisecond))
+		req, err := c.NewRequest(context.Background(), http.MethodPost, "search", nil, strings.NewReader(`{"q":1}`))
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+		if len(mock.requests) != 1 {
+			t.Errorf("expected 1 attempt, got %d", len(mock.requests))
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"strings"
+	"time"
+)
+
+// Observer receives the outcome of every HTTP exchange made by DoRequest, for
+// example to feed per-endpoint counters and latency histograms. With WithRetry,
+// each attempt is observed separately.
+type Observer interface {
+	// ObserveRequest reports the endpoint label, the response status code (0
+	// when no response was received), and the time spent waiting for the
+	// response headers.
+	ObserveRequest(endpoint string, statusCode int, latency time.Duration)
+}
+
+// WithObserver registers o to be notified of every request outcome. The endpoint
+// label is the request path with identifiers collapsed (see EndpointLabel), so
+// label cardinality stays bounded. A nil observer is ignored.
+func WithObserver(o Observer) Option {
+	return func(c *Client) {
+		if o != nil {
+			c.observer = o
+		}
+	}
+}
+
+// paramSegment replaces variable path segments in endpoint labels.
+const paramSegment = ":param"
+
+// EndpointLabel derives a bounded-cardinality label from a request path. Segments
+// made only of letters, and version segments such as v4 or v1.0.0, are kept. The
+// first segment that is neither, such as an ID, address part, or tile
+// coordinate, and every segment after it are replaced with ":param", since ATTOM
+// places path parameters at the end of the path.
+func EndpointLabel(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	variable := false
+	for i, seg := range segments {
+		if !variable && !isLetters(seg) && !isVersion(seg) {
+			variable = true
+		}
+		if variable {
+			segments[i] = paramSegment
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isLetters(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// isVersion reports whether s looks like v4 or v1.0.0.
+func isVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, part := range strings.Split(s[1:], ".") {
+		if part == "" {
+			return false
+		}
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type observation struct {
+	endpoint   string
+	statusCode int
+	latency    time.Duration
+}
+
+type recordingObserver struct {
+	observations []observation
+}
+
+func (o *recordingObserver) ObserveRequest(endpoint string, statusCode int, latency time.Duration) {
+	o.observations = append(o.observations, observation{endpoint, statusCode, latency})
+}
+
+// slowHTTPClient waits before delegating so observed latency is non-zero.
+type slowHTTPClient struct {
+	next  HTTPClient
+	delay time.Duration
+}
+
+func (m *slowHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	time.Sleep(m.delay)
+	return m.next.Do(req)
+}
+
+func TestWithObserver(t *testing.T) {
+	obs := &recordingObserver{}
+	mock := &slowHTTPClient{next: &staticHTTPClient{statusCode: http.StatusNotFound, body: "{}"}, delay: time.Millisecond}
+	c := New("key", mock, WithObserver(obs))
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "property/v2/salescomparables/propid/12345", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := c.DoRequest(req); err != nil {
+		t.Fatalf("DoRequest returned error: %v", err)
+	}
+
+	if len(obs.observations) != 1 {
+		t.Fatalf("expected 1 observation, got %d", len(obs.observations))
+	}
+	got := obs.observations[0]
+	if got.endpoint != "/property/v2/salescomparables/propid/:param" {
+		t.Errorf("endpoint = %q", got.endpoint)
+	}
+	if got.statusCode != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", got.statusCode)
+	}
+	if got.latency <= 0 {
+		t.Errorf("expected non-zero latency, got %v", got.latency)
+	}
+
+	t.Run("transport error reports status zero", func(t *testing.T) {
+		obs := &recordingObserver{}
+		c := New("key", &staticHTTPClient{err: errors.New("boom")}, WithObserver(obs))
+		req, err := c.NewRequest(context.Background(), http.MethodGet, "v4/property/detail", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err == nil {
+			t.Fatal("expected transport error")
+		}
+		if len(obs.observations) != 1 || obs.observations[0].statusCode != 0 || obs.observations[0].endpoint != "/v4/property/detail" {
+			t.Errorf("unexpected observations %+v", obs.observations)
+		}
+	})
+
+	t.Run("nil observer is ignored", func(t *testing.T) {
+		c := New("key", &staticHTTPClient{statusCode: http.StatusOK}, WithObserver(nil))
+		req, err := c.NewRequest(context.Background(), http.MethodGet, "v4/property/detail", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if _, err := c.DoRequest(req); err != nil {
+			t.Fatalf("DoRequest returned error: %v", err)
+		}
+	})
+}
+
+func TestEndpointLabel(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/v4/property/detail", want: "/v4/property/detail"},
+		{path: "/propertyapi/v1.0.0/allevents/detail", want: "/propertyapi/v1.0.0/allevents/detail"},
+		{path: "/v4/area/geoid/legacyLookup/", want: "/v4/area/geoid/legacyLookup"},
+		{path: "/property/v2/salescomparables/propid/100", want: "/property/v2/salescomparables/propid/:param"},
+		{path: "/property/v2/salescomparables/address/123 Main St/Springfield/Sangamon/IL/62704", want: "/property/v2/salescomparables/address/:param/:param/:param/:param/:param"},
+		{path: "/v4/parceltiles/10/512/341.png", want: "/v4/parceltiles/:param/:param/:param"},
+	}
+	for _, tt := range tests {
+		if got := EndpointLabel(tt.path); got != tt.want {
+			t.Errorf("EndpointLabel(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// redactedValue replaces secrets in recorded traffic.
+const redactedValue = "REDACTED"
+
+// RecordedExchange is the JSON line written by WithTrafficRecorder for each request.
+type RecordedExchange struct {
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestHeaders  http.Header `json:"requestHeaders,omitempty"`
+	StatusCode      int         `json:"status,omitempty"`
+	ResponseHeaders http.Header `json:"responseHeaders,omitempty"`
+	ResponseBody    string      `json:"responseBody,omitempty"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// trafficRecorder serializes recorded exchanges onto a shared writer.
+type trafficRecorder struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// WithTrafficRecorder writes one JSON line per request/response exchange to w,
+// which is useful for capturing fixtures from live traffic. The apikey header
+// and any apikey query parameter are redacted. Response bodies are buffered in
+// memory so the caller can still read them after they are recorded.
+func WithTrafficRecorder(w io.Writer) Option {
+	return func(c *Client) {
+		if w == nil {
+			return
+		}
+		c.recorder = &trafficRecorder{w: w}
+	}
+}
+
+// record writes the exchange and, when a response is present, replaces its body
+// with an in-memory copy.
+func (r *trafficRecorder) record(req *http.Request, resp *http.Response, doErr error) error {
+	u := *req.URL
+	if q := u.Query(); q.Has("apikey") {
+		q.Set("apikey", redactedValue)
+		u.RawQuery = q.Encode()
+	}
+	entry := RecordedExchange{
+		Method:         req.Method,
+		URL:            u.String(),
+		RequestHeaders: req.Header.Clone(),
+	}
+	if entry.RequestHeaders.Get("apikey") != "" {
+		entry.RequestHeaders.Set("apikey", redactedValue)
+	}
+	if doErr != nil {
+		entry.Error = doErr.Error()
+	}
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		entry.ResponseHeaders = resp.Header.Clone()
+		if resp.Body != nil {
+			body, err := io.ReadAll(resp.Body)
+			if closeErr := resp.Body.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if err != nil {
+				return fmt.Errorf("failed to read response body for recording: %w", err)
+			}
+			entry.ResponseBody = string(body)
+		}
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode recorded exchange: %w", err)
+	}
+	line = append(line, '\n')
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.w.Write(line); err != nil {
+		return fmt.Errorf("failed to write recorded exchange: %w", err)
+	}
+	return nil
+}
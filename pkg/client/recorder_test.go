@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// staticHTTPClient returns a fresh response with the configured body on every call.
+type staticHTTPClient struct {
+	statusCode int
+	body       string
+	err        error
+}
+
+func (m *staticHTTPClient) Do(_ *http.Request) (*http.Response, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", testContentTypeJSON)
+	return &http.Response{StatusCode: m.statusCode, Header: header, Body: io.NopCloser(strings.NewReader(m.body))}, nil
+}
+
+func TestWithTrafficRecorder(t *testing.T) {
+	var buf bytes.Buffer
+	mock := &staticHTTPClient{statusCode: http.StatusOK, body: `{"status":{"code":0}}`}
+	c := New("secret-key", mock, WithBaseURL("https://example.com/"), WithTrafficRecorder(&buf))
+
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "v4/property/detail", url.Values{"attomid": {"100"}, "apikey": {"secret-key"}}, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	resp, err := c.DoRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	if string(body) != `{"status":{"code":0}}` {
+		t.Errorf("response body not preserved after recording: %q", body)
+	}
+
+	if strings.Contains(buf.String(), "secret-key") {
+		t.Fatalf("recorded exchange leaked the API key: %s", buf.String())
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one recorded line, got %d", len(lines))
+	}
+	var entry RecordedExchange
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("recorded line is not valid JSON: %v", err)
+	}
+	if entry.Method != http.MethodGet {
+		t.Errorf("method = %q, want GET", entry.Method)
+	}
+	if !strings.HasPrefix(entry.URL, "https://example.com/v4/property/detail?") || !strings.Contains(entry.URL, "attomid=100") {
+		t.Errorf("unexpected URL %q", entry.URL)
+	}
+	if entry.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", entry.StatusCode)
+	}
+	if entry.ResponseBody != `{"status":{"code":0}}` {
+		t.Errorf("unexpected recorded body %q", entry.ResponseBody)
+	}
+	if entry.RequestHeaders.Get("apikey") != redactedValue {
+		t.Errorf("expected redacted apikey header, got %q", entry.RequestHeaders.Get("apikey"))
+	}
+}
+
+func TestWithTrafficRecorder_TransportError(t *testing.T) {
+	var buf bytes.Buffer
+	c := New("key", &staticHTTPClient{err: errors.New("connection reset")}, WithTrafficRecorder(&buf))
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if _, err := c.DoRequest(req); err == nil {
+		t.Fatalf("expected transport error")
+	}
+	var entry RecordedExchange
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("recorded line is not valid JSON: %v", err)
+	}
+	if entry.Error != "connection reset" {
+		t.Errorf("expected recorded error, got %q", entry.Error)
+	}
+}
+
+// failingWriter always returns an error from Write.
+type failingWriter struct{}
+
+func (failingWriter) Write(_ []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestWithTrafficRecorder_WriteError(t *testing.T) {
+	c := New("key", &staticHTTPClient{statusCode: http.StatusOK, body: "{}"}, WithTrafficRecorder(failingWriter{}))
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if _, err := c.DoRequest(req); err == nil || !strings.Contains(err.Error(), "failed to write recorded exchange") {
+		t.Errorf("expected recorder write error, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -12,8 +13,14 @@ import (
 // NewRequest constructs an HTTP request relative to the client's base URL.
 //
 // The endpoint must be a relative path without leading scheme. Query parameters
-// are optional and will be URL-encoded. The Accept header defaults to
-// application/json when not already provided.
+// are optional and will be URL-encoded. Headers from WithDefaultHeaders are
+// copied onto the request. The Accept header defaults to application/json,
+// User-Agent to the client's configured value, and Accept-Encoding to gzip
+// unless compression is disabled, when not already provided.
+//
+// A non-nil body is buffered in memory and exposed through req.GetBody so the
+// request can be replayed by redirects and WithRetry. Large payloads are
+// therefore held in memory for the lifetime of the request.
 func (c *Client) NewRequest(ctx context.Context, method, endpoint string, query url.Values, body io.Reader) (*http.Request, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("context cannot be nil")
@@ -25,6 +32,10 @@ func (c *Client) NewRequest(ctx context.Context, method, endpoint string, query
 		return nil, fmt.Errorf("endpoint cannot be empty")
 	}
 
+	if c.baseURLErr != nil {
+		return nil, c.baseURLErr
+	}
+
 	base, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL %q: %w", c.baseURL, err)
@@ -36,12 +47,26 @@ func (c *Client) NewRequest(ctx context.Context, method, endpoint string, query
 		rel.RawQuery = query.Encode()
 	}
 
+	body, err = replayableBody(body)
+	if err != nil {
+		return nil, err
+	}
+
 	finalURL := base.ResolveReference(rel)
 	req, err := http.NewRequestWithContext(ctx, method, finalURL.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	for key, values := range c.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	if c.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if !c.disableCompression && req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
 	if req.Header.Get("Accept") == "" {
 		req.Header.Set("Accept", "application/json")
 	}
@@ -51,3 +76,26 @@ func (c *Client) NewRequest(ctx context.Context, method, endpoint string, query
 
 	return req, nil
 }
+
+// replayableBody returns body in a form for which http.NewRequestWithContext
+// sets GetBody. Bytes and string readers already qualify; any other reader is
+// read fully into memory and closed if it is an io.Closer.
+func replayableBody(body io.Reader) (io.Reader, error) {
+	switch body.(type) {
+	case nil, *bytes.Buffer, *bytes.Reader, *strings.Reader:
+		return body, nil
+	}
+	if body == http.NoBody {
+		return body, nil
+	}
+	data, err := io.ReadAll(body)
+	if closer, ok := body.(io.Closer); ok {
+		if closeErr := closer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to buffer request body: %w", err)
+	}
+	return bytes.NewReader(data), nil
+}
@@ -0,0 +1,297 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryableStatusCodes are the response status codes retried by WithRetry
+// unless overridden with WithRetryableStatusCodes.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy holds the configuration installed by WithRetry.
+type retryPolicy struct {
+	statusCodes map[int]struct{}
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// WithRetry enables automatic retries of idempotent requests: GET and HEAD, and
+// POST requests carrying an idempotency key, provided the body can be replayed.
+// A request is attempted at most maxAttempts times and is retried when the
+// response status is retryable (DefaultRetryableStatusCodes, or those set with
+// WithRetryableStatusCodes) or when the transport error satisfies IsRetryable.
+// The wait before retry n is baseDelay doubled n-1 times, with up to half of it
+// replaced by random jitter. Cancelling the request's context stops the loop
+// immediately. Status codes registered with WithAbortOnStatusCodes and 4xx
+// responses are never retried. A maxAttempts below 2 disables retries.
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(c *Client) {
+		if maxAttempts < 2 {
+			if c.retry != nil {
+				c.retry.maxAttempts = 1
+			}
+			return
+		}
+		if c.retry == nil {
+			c.retry = newRetryPolicy()
+		}
+		c.retry.maxAttempts = maxAttempts
+		c.retry.baseDelay = max(baseDelay, 0)
+	}
+}
+
+// WithRetryableStatusCodes replaces the response status codes that WithRetry
+// treats as transient. Codes below 500 are ignored so client errors are never
+// retried. It has no effect unless WithRetry is also configured.
+func WithRetryableStatusCodes(codes ...int) Option {
+	return func(c *Client) {
+		if c.retry == nil {
+			c.retry = newRetryPolicy()
+			c.retry.maxAttempts = 1
+		}
+		c.retry.statusCodes = make(map[int]struct{}, len(codes))
+		for _, code := range codes {
+			if code >= http.StatusInternalServerError {
+				c.retry.statusCodes[code] = struct{}{}
+			}
+		}
+	}
+}
+
+func newRetryPolicy() *retryPolicy {
+	p := &retryPolicy{statusCodes: make(map[int]struct{}, len(DefaultRetryableStatusCodes))}
+	for _, code := range DefaultRetryableStatusCodes {
+		p.statusCodes[code] = struct{}{}
+	}
+	return p
+}
+
+// retryableRequest reports whether req is idempotent and can be sent again.
+// POST requests qualify only when they carry an Idempotency-Key header.
+func retryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost:
+		if req.Header.Get(IdempotencyKeyHeader) == "" {
+			return false
+		}
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// run sends req through attempt, retrying according to the policy.
+func (p *retryPolicy) run(req *http.Request, attempt func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	for n := 1; ; n++ {
+		if n > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+		resp, err := attempt(req)
+		if n >= p.maxAttempts || !p.shouldRetry(resp, err) {
+			return resp, err
+		}
+		if resp != nil && resp.Body != nil {
+			_, drainErr := io.Copy(io.Discard, resp.Body)
+			if closeErr := resp.Body.Close(); closeErr != nil || drainErr != nil {
+				return nil, fmt.Errorf("failed to discard response body before retry: %w", errors.Join(drainErr, closeErr))
+			}
+		}
+		timer := time.NewTimer(p.delay(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("failed to execute request: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient.
+func (p *retryPolicy) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return IsRetryable(err)
+	}
+	_, ok := p.statusCodes[resp.StatusCode]
+	return ok
+}
+
+// delay returns the wait before the retry following attempt n: baseDelay doubled
+// n-1 times, with the upper half randomized.
+func (p *retryPolicy) delay(n int) time.Duration {
+	d := p.baseDelay << min(n-1, 30)
+	if d <= 0 {
+		return p.baseDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// TimeoutKind classifies where in the request lifecycle a timeout occurred.
+type TimeoutKind int
+
+const (
+	// TimeoutNone indicates the error is not a transport timeout.
+	TimeoutNone TimeoutKind = iota
+	// TimeoutConnect indicates the timeout happened before response headers
+	// arrived: while dialing, during the TLS handshake, or awaiting headers.
+	TimeoutConnect
+	// TimeoutRead indicates the timeout happened while reading the response body.
+	TimeoutRead
+)
+
+// String returns a readable name for the timeout kind.
+func (k TimeoutKind) String() string {
+	switch k {
+	case TimeoutConnect:
+		return "connect"
+	case TimeoutRead:
+		return "read"
+	default:
+		return "none"
+	}
+}
+
+// ClassifyTimeout reports whether err is a connect-phase or read-phase timeout.
+//
+// Read-phase timeouts are recognized on the bodies of responses returned by
+// DoRequest, which mark their read errors; every other timeout, including a
+// socket read that times out while awaiting headers, happened before a response
+// arrived and is reported as TimeoutConnect. Deadlines imposed by the caller's
+// context are reported as TimeoutNone since retrying cannot succeed once the
+// caller's budget is spent.
+func ClassifyTimeout(err error) TimeoutKind {
+	if err == nil || isContextDeadline(err) {
+		return TimeoutNone
+	}
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		return TimeoutNone
+	}
+	var readErr *bodyReadError
+	if errors.As(err, &readErr) {
+		return TimeoutRead
+	}
+	return TimeoutConnect
+}
+
+// isContextDeadline reports whether err wraps context.DeadlineExceeded itself, as
+// errors from an expired request context do. http.Client.Timeout errors match
+// context.DeadlineExceeded with errors.Is but do not wrap it, so they are not
+// reported.
+func isContextDeadline(err error) bool {
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			return isContextDeadline(inner)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			if isContextDeadline(inner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bodyReadError marks an error returned while reading a response body, so
+// ClassifyTimeout can tell read-phase timeouts from those awaiting headers.
+type bodyReadError struct {
+	err error
+}
+
+func (e *bodyReadError) Error() string { return e.err.Error() }
+func (e *bodyReadError) Unwrap() error { return e.err }
+
+// readPhaseBody wraps a response body so that its read errors, other than
+// io.EOF, are marked as bodyReadErrors.
+type readPhaseBody struct {
+	io.ReadCloser
+}
+
+// Read reads from the underlying body, marking any error other than io.EOF.
+func (b *readPhaseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = &bodyReadError{err: err}
+	}
+	return n, err
+}
+
+// IsRetryable reports whether a transport error is safe to retry unchanged.
+//
+// Connect-phase timeouts, dial failures, and connections dropped before a
+// response arrived (reset by the peer, or closed with an EOF or unexpected EOF)
+// are retryable because the request was not answered; WithRetry only resends
+// idempotent requests. Body read timeouts and other errors while reading a
+// response body are not: the server already answered and a large payload is
+// likely to time out again with the same read budget, so callers should handle
+// them explicitly (for example by retrying with a longer timeout). Terminal
+// errors from WithAbortOnStatusCodes and context cancellation are never
+// retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, ErrAborted) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	switch ClassifyTimeout(err) {
+	case TimeoutConnect:
+		return true
+	case TimeoutRead:
+		return false
+	}
+	var readErr *bodyReadError
+	if errors.As(err, &readErr) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// ParseRetryAfter parses a Retry-After header value given either as a number of
+// seconds or as an HTTP date, returning the delay relative to now. Dates in the
+// past yield a zero delay. The boolean is false when the value is missing or
+// malformed.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(at.Sub(now), 0), true
+}
@@ -0,0 +1,410 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// timeoutError is a net.Error that always reports a timeout.
+type timeoutError struct{ msg string }
+
+func (e timeoutError) Error() string   { return e.msg }
+func (e timeoutError) Timeout() bool   { return true }
+func (e timeoutError) Temporary() bool { return true }
+
+func TestClassifyTimeout(t *testing.T) {
+	dialTimeout := &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{msg: "i/o timeout"}}}
+	headerReadTimeout := &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{msg: "i/o timeout"}}}
+	headerTimeout := &url.Error{Op: "Get", URL: "https://example.com", Err: timeoutError{msg: "net/http: request canceled"}}
+	bodyTimeout := fmt.Errorf("property: failed to decode response: %w", &bodyReadError{err: &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{msg: "i/o timeout"}}})
+
+	tests := []struct {
+		name string
+		err  error
+		want TimeoutKind
+	}{
+		{name: "nil", err: nil, want: TimeoutNone},
+		{name: "plain error", err: errors.New("boom"), want: TimeoutNone},
+		{name: "dial timeout", err: dialTimeout, want: TimeoutConnect},
+		{name: "socket read awaiting headers", err: headerReadTimeout, want: TimeoutConnect},
+		{name: "awaiting headers", err: headerTimeout, want: TimeoutConnect},
+		{name: "body read timeout", err: bodyTimeout, want: TimeoutRead},
+		{name: "body read error without timeout", err: &bodyReadError{err: io.ErrUnexpectedEOF}, want: TimeoutNone},
+		{name: "context deadline", err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), want: TimeoutNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyTimeout(tt.err); got != tt.want {
+				t.Errorf("ClassifyTimeout() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyTimeoutRealErrors(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow-body" {
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write([]byte(`{"status":`)); err != nil {
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	newClient := func(timeout time.Duration) *Client {
+		return New("test-key", &http.Client{Timeout: timeout}, WithBaseURL(srv.URL+"/"))
+	}
+	doRequest := func(t *testing.T, ctx context.Context, c *Client, path string) (*http.Response, error) {
+		t.Helper()
+		req, err := c.NewRequest(ctx, http.MethodGet, path, nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		return c.DoRequest(req)
+	}
+
+	t.Run("client timeout awaiting headers", func(t *testing.T) {
+		_, err := doRequest(t, context.Background(), newClient(50*time.Millisecond), "slow-headers")
+		if err == nil {
+			t.Fatal("expected timeout error")
+		}
+		if got := ClassifyTimeout(err); got != TimeoutConnect {
+			t.Errorf("ClassifyTimeout(%v) = %s, want connect", err, got)
+		}
+		if !IsRetryable(err) {
+			t.Errorf("expected %v to be retryable", err)
+		}
+	})
+
+	t.Run("client timeout while the body stalls", func(t *testing.T) {
+		resp, err := doRequest(t, context.Background(), newClient(100*time.Millisecond), "slow-body")
+		if err != nil {
+			t.Fatalf("unexpected error awaiting headers: %v", err)
+		}
+		_, err = io.ReadAll(resp.Body)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			t.Errorf("close body: %v", closeErr)
+		}
+		if err == nil {
+			t.Fatal("expected timeout error")
+		}
+		if got := ClassifyTimeout(err); got != TimeoutRead {
+			t.Errorf("ClassifyTimeout(%v) = %s, want read", err, got)
+		}
+		if IsRetryable(err) {
+			t.Errorf("expected %v not to be retryable", err)
+		}
+	})
+
+	t.Run("caller context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := doRequest(t, ctx, newClient(0), "slow-headers")
+		if err == nil {
+			t.Fatal("expected deadline error")
+		}
+		if got := ClassifyTimeout(err); got != TimeoutNone {
+			t.Errorf("ClassifyTimeout(%v) = %s, want none", err, got)
+		}
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "connect timeout", err: &net.OpError{Op: "dial", Err: timeoutError{msg: "i/o timeout"}}, want: true},
+		{name: "connection refused", err: fmt.Errorf("failed to execute request: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: true},
+		{name: "read timeout awaiting headers", err: &net.OpError{Op: "read", Err: timeoutError{msg: "i/o timeout"}}, want: true},
+		{name: "body read timeout", err: &bodyReadError{err: &net.OpError{Op: "read", Err: timeoutError{msg: "i/o timeout"}}}, want: false},
+		{name: "connection reset", err: &url.Error{Op: "Get", URL: "https://example.com", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}, want: true},
+		{name: "unexpected EOF", err: fmt.Errorf("failed to execute request: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "connection closed", err: &url.Error{Op: "Get", URL: "https://example.com", Err: io.EOF}, want: true},
+		{name: "reset while reading body", err: &bodyReadError{err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}, want: false},
+		{name: "aborted", err: &AbortError{StatusCode: 401}, want: false},
+		{name: "canceled", err: context.Canceled, want: false},
+		{name: "unrelated", err: errors.New("boom"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// sequenceHTTPClient replays a scripted series of outcomes, one per call.
+type sequenceHTTPClient struct {
+	outcomes []func() (*http.Response, error)
+	calls    int
+}
+
+func (m *sequenceHTTPClient) Do(_ *http.Request) (*http.Response, error) {
+	i := m.calls
+	m.calls++
+	if i >= len(m.outcomes) {
+		i = len(m.outcomes) - 1
+	}
+	return m.outcomes[i]()
+}
+
+func status(code int) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader("body")), Header: make(http.Header)}, nil
+	}
+}
+
+func transportErr(err error) func() (*http.Response, error) {
+	return func() (*http.Response, error) { return nil, err }
+}
+
+func TestWithRetry(t *testing.T) {
+	dialTimeout := &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{msg: "i/o timeout"}}
+	headerReadTimeout := &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{msg: "i/o timeout"}}
+
+	tests := []struct {
+		name       string
+		outcomes   []func() (*http.Response, error)
+		opts       []Option
+		method     string
+		wantCalls  int
+		wantStatus int
+		wantErr    bool
+	}{
+		{
+			name:       "fails twice then succeeds",
+			outcomes:   []func() (*http.Response, error){status(http.StatusServiceUnavailable), transportErr(dialTimeout), status(http.StatusOK)},
+			wantCalls:  3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "exhausted attempts return last response",
+			outcomes:   []func() (*http.Response, error){status(http.StatusBadGateway)},
+			wantCalls:  3,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "4xx is not retried",
+			outcomes:   []func() (*http.Response, error){status(http.StatusNotFound), status(http.StatusOK)},
+			wantCalls:  1,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "dropped connection is retried",
+			outcomes:   []func() (*http.Response, error){transportErr(&url.Error{Op: "Get", URL: "https://example.com", Err: io.ErrUnexpectedEOF}), status(http.StatusOK)},
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "read timeout awaiting headers is retried",
+			outcomes:   []func() (*http.Response, error){transportErr(headerReadTimeout), status(http.StatusOK)},
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:      "abort codes are not retried",
+			outcomes:  []func() (*http.Response, error){status(http.StatusServiceUnavailable), status(http.StatusOK)},
+			opts:      []Option{WithAbortOnStatusCodes(http.StatusServiceUnavailable)},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:       "POST is not retried",
+			outcomes:   []func() (*http.Response, error){status(http.StatusServiceUnavailable), status(http.StatusOK)},
+			method:     http.MethodPost,
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "custom status codes replace defaults",
+			outcomes:   []func() (*http.Response, error){status(http.StatusServiceUnavailable), status(http.StatusOK)},
+			opts:       []Option{WithRetryableStatusCodes(http.StatusInternalServerError)},
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "custom status codes ignore 4xx",
+			outcomes:   []func() (*http.Response, error){status(http.StatusTooManyRequests), status(http.StatusOK)},
+			opts:       []Option{WithRetryableStatusCodes(http.StatusTooManyRequests)},
+			wantCalls:  1,
+			wantStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:       "custom attempt count",
+			outcomes:   []func() (*http.Response, error){status(http.StatusInternalServerError)},
+			opts:       []Option{WithRetry(5, time.Millisecond)},
+			wantCalls:  5,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &sequenceHTTPClient{outcomes: tt.outcomes}
+			opts := append([]Option{WithRetry(3, time.Millisecond)}, tt.opts...)
+			c := New("key", mock, opts...)
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req, err := c.NewRequest(context.Background(), method, "endpoint", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest returned error: %v", err)
+			}
+			resp, err := c.DoRequest(req)
+			if mock.calls != tt.wantCalls {
+				t.Errorf("expected %d attempts, got %d", tt.wantCalls, mock.calls)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got status %d", resp.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer func() {
+				if cerr := resp.Body.Close(); cerr != nil {
+					t.Errorf("close: %v", cerr)
+				}
+			}()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWithRetry_ContextCancelStopsLoop(t *testing.T) {
+	mock := &sequenceHTTPClient{outcomes: []func() (*http.Response, error){status(http.StatusServiceUnavailable)}}
+	c := New("key", mock, WithRetry(10, time.Hour))
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := c.NewRequest(ctx, http.MethodGet, "endpoint", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.DoRequest(req)
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("retry loop did not stop after cancellation")
+	}
+	if mock.calls != 1 {
+		t.Errorf("expected 1 attempt before cancellation, got %d", mock.calls)
+	}
+}
+
+func TestWithRetry_DroppedConnection(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("hijack: %v", err)
+				return
+			}
+			if err := conn.Close(); err != nil {
+				t.Errorf("close connection: %v", err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := New("key", &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}, WithBaseURL(srv.URL+"/"), WithRetry(3, time.Millisecond))
+	req, err := c.NewRequest(context.Background(), http.MethodGet, "endpoint", nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	resp, err := c.DoRequest(req)
+	if err != nil {
+		t.Fatalf("expected the dropped connection to be retried, got %v", err)
+	}
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		t.Errorf("close body: %v", closeErr)
+	}
+	if resp.StatusCode != http.StatusOK || hits.Load() != 2 {
+		t.Errorf("expected 200 after 2 attempts, got %d after %d", resp.StatusCode, hits.Load())
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := &retryPolicy{baseDelay: 100 * time.Millisecond}
+	for n := 1; n <= 4; n++ {
+		full := p.baseDelay << (n - 1)
+		for range 20 {
+			if d := p.delay(n); d < full/2 || d > full {
+				t.Fatalf("delay(%d) = %v, want within [%v, %v]", n, d, full/2, full)
+			}
+		}
+	}
+	if d := (&retryPolicy{}).delay(3); d != 0 {
+		t.Errorf("expected zero delay for zero base, got %v", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "seconds", value: "120", want: 2 * time.Minute, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "http date", value: "Mon, 03 Nov 2025 12:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{name: "past http date", value: "Mon, 03 Nov 2025 11:59:00 GMT", want: 0, wantOK: true},
+		{name: "missing", value: ""},
+		{name: "negative", value: "-5"},
+		{name: "garbage", value: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseRetryAfter(tt.value, now)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
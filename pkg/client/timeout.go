@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithDefaultTimeout bounds requests whose context has no deadline by d,
+// covering every retry attempt and the reading of the response body. Contexts
+// that already carry a deadline are left untouched. A non-positive d disables
+// the default, which is the initial behavior.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		if d < 0 {
+			d = 0
+		}
+		c.requestTimeout = d
+	}
+}
+
+// withDefaultTimeout returns req bound by the default timeout along with the
+// function releasing it. The cancel function is nil when req is unchanged.
+func (c *Client) withDefaultTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	if c.requestTimeout <= 0 {
+		return req, nil
+	}
+	if _, ok := req.Context().Deadline(); ok {
+		return req, nil
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), c.requestTimeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelBody releases a request context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the underlying body and cancels the request context.
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// cancelOnClose defers cancel until resp's body is closed, or calls it at once
+// when the request failed or the response has no body.
+func cancelOnClose(resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
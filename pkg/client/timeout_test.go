@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingHTTPClient waits for delay or the request context, whichever ends first,
+// and records the context it was called with.
+type blockingHTTPClient struct {
+	ctx   context.Context
+	delay time.Duration
+}
+
+func (m *blockingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	m.ctx = req.Context()
+	select {
+	case <-time.After(m.delay):
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Header: make(http.Header)}, nil
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+func TestWithDefaultTimeout(t *testing.T) {
+	newRequest := func(t *testing.T, c *Client, ctx context.Context) *http.Request {
+		t.Helper()
+		req, err := c.NewRequest(ctx, http.MethodGet, "v4/property/detail", nil, nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		return req
+	}
+
+	t.Run("slow response exceeds timeout", func(t *testing.T) {
+		mock := &blockingHTTPClient{delay: time.Second}
+		c := New("test-key", mock, WithDefaultTimeout(20*time.Millisecond))
+		_, err := c.DoRequest(newRequest(t, c, context.Background()))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline error, got %v", err)
+		}
+	})
+
+	t.Run("body close releases context", func(t *testing.T) {
+		mock := &blockingHTTPClient{}
+		c := New("test-key", mock, WithDefaultTimeout(time.Minute))
+		resp, err := c.DoRequest(newRequest(t, c, context.Background()))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := mock.ctx.Deadline(); !ok {
+			t.Fatalf("expected request context to carry a deadline")
+		}
+		if mock.ctx.Err() != nil {
+			t.Fatalf("context canceled before body was closed")
+		}
+		if err := resp.Body.Close(); err != nil {
+			t.Fatalf("close: %v", err)
+		}
+		if !errors.Is(mock.ctx.Err(), context.Canceled) {
+			t.Errorf("expected context canceled after close, got %v", mock.ctx.Err())
+		}
+	})
+
+	t.Run("existing deadline is kept", func(t *testing.T) {
+		mock := &blockingHTTPClient{}
+		c := New("test-key", mock, WithDefaultTimeout(time.Millisecond))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+		resp, err := c.DoRequest(newRequest(t, c, ctx))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				t.Errorf("close: %v", err)
+			}
+		}()
+		want, _ := ctx.Deadline()
+		if got, _ := mock.ctx.Deadline(); !got.Equal(want) {
+			t.Errorf("expected caller deadline %v, got %v", want, got)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		mock := &blockingHTTPClient{}
+		c := New("test-key", mock)
+		resp, err := c.DoRequest(newRequest(t, c, context.Background()))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := resp.Body.Close(); err != nil {
+			t.Fatalf("close: %v", err)
+		}
+		if _, ok := mock.ctx.Deadline(); ok {
+			t.Errorf("expected no deadline without WithDefaultTimeout")
+		}
+	})
+}
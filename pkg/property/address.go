@@ -12,3 +12,57 @@ func ValidateFIPSAndAPN(fips, apn string) error {
 	}
 	return nil
 }
+
+// NormalizePostalCode strips every non-digit from code and splits the result into
+// the 5-digit ZIP and the optional 4-digit ZIP+4 extension. Inputs such as
+// "12345", "12345-6789", "12345 6789", and "123456789" are accepted; anything
+// that does not reduce to 5 or 9 digits is rejected.
+func NormalizePostalCode(code string) (zip5, plus4 string, err error) {
+	var digits strings.Builder
+	for _, r := range code {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	d := digits.String()
+	switch len(d) {
+	case 5:
+		return d, "", nil
+	case 9:
+		return d[:5], d[5:], nil
+	default:
+		return "", "", fmt.Errorf("invalid postal code: %q (must contain 5 or 9 digits)", code)
+	}
+}
+
+// String formats the address on a single line, e.g.
+// "123 Main St #4, Springfield, IL 62701". Nil and blank components are skipped
+// without leaving stray separators. Line2 is used only when City, State, and
+// PostalCode are all missing, since ATTOM repeats them there. A nil receiver
+// yields an empty string.
+func (a *Address) String() string {
+	if a == nil {
+		return ""
+	}
+	street := strings.TrimSpace(a.GetLine1())
+	if unit := strings.TrimSpace(a.GetUnitNumber()); unit != "" && !strings.Contains(street, unit) {
+		if !strings.HasPrefix(unit, "#") && !strings.Contains(unit, " ") {
+			unit = "#" + unit
+		}
+		street = strings.TrimSpace(street + " " + unit)
+	}
+
+	city := strings.TrimSpace(a.GetCity())
+	stateZip := strings.TrimSpace(strings.TrimSpace(a.GetState()) + " " + strings.TrimSpace(a.GetPostalCode()))
+	components := []string{street, city, stateZip}
+	if city == "" && stateZip == "" {
+		components = []string{street, strings.TrimSpace(a.GetLine2())}
+	}
+	parts := make([]string, 0, len(components))
+	for _, part := range components {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,104 @@
+package property
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestNormalizePostalCode(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantZip5  string
+		wantPlus4 string
+		wantErr   bool
+	}{
+		{name: "five digit", input: "12345", wantZip5: "12345"},
+		{name: "zip plus four with hyphen", input: "12345-6789", wantZip5: "12345", wantPlus4: "6789"},
+		{name: "zip plus four with space", input: "12345 6789", wantZip5: "12345", wantPlus4: "6789"},
+		{name: "nine digits", input: "123456789", wantZip5: "12345", wantPlus4: "6789"},
+		{name: "surrounding whitespace", input: "  02134 ", wantZip5: "02134"},
+		{name: "too short", input: "1234", wantErr: true},
+		{name: "partial extension", input: "12345-67", wantErr: true},
+		{name: "letters only", input: "ABCDE", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zip5, plus4, err := NormalizePostalCode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizePostalCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if zip5 != tt.wantZip5 || plus4 != tt.wantPlus4 {
+				t.Errorf("NormalizePostalCode() = (%q, %q), want (%q, %q)", zip5, plus4, tt.wantZip5, tt.wantPlus4)
+			}
+		})
+	}
+}
+
+func TestWithPostalCodeNormalization(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "12345", want: "12345"},
+		{input: "12345-6789", want: "12345"},
+		{input: " 12345 ", want: "12345"},
+		{input: "K1A 0B1", want: "K1A 0B1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			vals := url.Values{}
+			WithPostalCode(tt.input)(vals)
+			if vals.Get("postalCode") != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, vals.Get("postalCode"))
+			}
+		})
+	}
+}
+
+func TestAddressString(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		address *Address
+		want    string
+	}{
+		{
+			name:    "full address",
+			address: &Address{Line1: str("123 Main St"), UnitNumber: str("4"), Line2: str("SPRINGFIELD, IL 62701"), City: str("Springfield"), State: str("IL"), PostalCode: str("62701")},
+			want:    "123 Main St #4, Springfield, IL 62701",
+		},
+		{
+			name:    "no unit or line2",
+			address: &Address{Line1: str(" 123 Main St "), City: str("Springfield"), State: str("IL"), PostalCode: str("62701")},
+			want:    "123 Main St, Springfield, IL 62701",
+		},
+		{
+			name:    "missing city and zip",
+			address: &Address{Line1: str("123 Main St"), City: str(""), State: str("IL")},
+			want:    "123 Main St, IL",
+		},
+		{
+			name:    "line2 fallback",
+			address: &Address{Line1: str("123 Main St"), Line2: str("SPRINGFIELD, IL 62701")},
+			want:    "123 Main St, SPRINGFIELD, IL 62701",
+		},
+		{
+			name:    "unit already in line1",
+			address: &Address{Line1: str("123 Main St APT 4"), UnitNumber: str("APT 4"), City: str("Springfield")},
+			want:    "123 Main St APT 4, Springfield",
+		},
+		{name: "all nil", address: &Address{}, want: ""},
+		{name: "nil receiver", address: nil, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.address.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Event types reported in AllEventsRecord.EventType, matched case-insensitively
+// by the AllEventsRecord decode helpers.
+const (
+	EventTypeSale       = "sale"
+	EventTypeAssessment = "assessment"
+)
+
+// AsSale decodes the record's raw payload as a Sale. It returns an error when the
+// record is not a sale event or the payload cannot be decoded.
+func (a *AllEventsRecord) AsSale() (*Sale, error) {
+	var sale Sale
+	if err := a.decodeRaw(EventTypeSale, &sale); err != nil {
+		return nil, err
+	}
+	return &sale, nil
+}
+
+// AsAssessment decodes the record's raw payload as an Assessment. It returns an
+// error when the record is not an assessment event or the payload cannot be
+// decoded.
+func (a *AllEventsRecord) AsAssessment() (*Assessment, error) {
+	var assessment Assessment
+	if err := a.decodeRaw(EventTypeAssessment, &assessment); err != nil {
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+// decodeRaw unmarshals Raw into out after checking that the record is an event
+// of type eventType.
+func (a *AllEventsRecord) decodeRaw(eventType string, out interface{}) error {
+	if got := a.GetEventType(); !strings.EqualFold(strings.TrimSpace(got), eventType) {
+		return fmt.Errorf("property: event type %q does not match %q", got, eventType)
+	}
+	if len(a.Raw) == 0 {
+		return fmt.Errorf("property: %s event has no payload", eventType)
+	}
+	if err := json.Unmarshal(a.Raw, out); err != nil {
+		return fmt.Errorf("property: failed to decode %s event: %w", eventType, err)
+	}
+	return nil
+}
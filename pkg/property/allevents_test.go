@@ -0,0 +1,62 @@
+package property
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAllEventsRecordDecode(t *testing.T) {
+	var resp AllEventsDetailResponse
+	body := `{"status":{},"event":[
+		{"eventType":"SALE","eventDate":"2021-06-15","raw":{"saleDate":"2021-06-15","amount":{"saleAmt":410000}}},
+		{"eventType":"assessment","eventDate":"2024-01-01","raw":{"assdTtlValue":"350000","taxYear":2024}}
+	]}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	saleEvent, assessmentEvent := resp.Events[0], resp.Events[1]
+
+	sale, err := saleEvent.AsSale()
+	if err != nil {
+		t.Fatalf("AsSale: %v", err)
+	}
+	if sale.GetSaleDate() != "2021-06-15" {
+		t.Errorf("unexpected sale %+v", sale)
+	}
+	if amount, ok := sale.SaleAmount(); !ok || amount != 410000 {
+		t.Errorf("expected sale amount 410000, got %v (ok=%v)", amount, ok)
+	}
+
+	assessment, err := assessmentEvent.AsAssessment()
+	if err != nil {
+		t.Fatalf("AsAssessment: %v", err)
+	}
+	if assessment.GetAssessedTotalValue() != 350000 || assessment.GetTaxYear() != 2024 {
+		t.Errorf("unexpected assessment %+v", assessment)
+	}
+
+	t.Run("type mismatch", func(t *testing.T) {
+		if _, err := saleEvent.AsAssessment(); err == nil || !strings.Contains(err.Error(), `event type "SALE" does not match "assessment"`) {
+			t.Errorf("expected type mismatch error, got %v", err)
+		}
+		if _, err := assessmentEvent.AsSale(); err == nil {
+			t.Errorf("expected type mismatch error")
+		}
+		var nilRecord *AllEventsRecord
+		if _, err := nilRecord.AsSale(); err == nil {
+			t.Errorf("expected error for nil record")
+		}
+	})
+
+	t.Run("missing or malformed payload", func(t *testing.T) {
+		saleType := EventTypeSale
+		if _, err := (&AllEventsRecord{EventType: &saleType}).AsSale(); err == nil || !strings.Contains(err.Error(), "no payload") {
+			t.Errorf("expected missing payload error, got %v", err)
+		}
+		record := &AllEventsRecord{EventType: &saleType, Raw: json.RawMessage(`[1,2]`)}
+		if _, err := record.AsSale(); err == nil || !strings.Contains(err.Error(), "failed to decode sale event") {
+			t.Errorf("expected decode error, got %v", err)
+		}
+	})
+}
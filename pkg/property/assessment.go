@@ -0,0 +1,19 @@
+package property
+
+// AssessmentRatio returns the assessed total value divided by the market total value.
+// The boolean is false when either value is missing or the market total is zero.
+func (a *Assessment) AssessmentRatio() (float64, bool) {
+	if a == nil || a.AssessedTotalValue == nil || a.MarketTotalValue == nil || *a.MarketTotalValue == 0 {
+		return 0, false
+	}
+	return a.AssessedTotalValue.Float64() / a.MarketTotalValue.Float64(), true
+}
+
+// TaxAmountMoney returns the tax amount as Money, rounded to the nearest cent.
+// The boolean is false when the assessment or its tax amount is missing.
+func (a *Assessment) TaxAmountMoney() (Money, bool) {
+	if a == nil || a.TaxAmount == nil {
+		return 0, false
+	}
+	return MoneyFromFloat(a.TaxAmount.Float64()), true
+}
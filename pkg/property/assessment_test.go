@@ -0,0 +1,34 @@
+package property
+
+import "testing"
+
+func TestAssessmentRatio(t *testing.T) {
+	float := func(v float64) *FlexFloat { f := FlexFloat(v); return &f }
+
+	tests := []struct {
+		name       string
+		assessment *Assessment
+		want       float64
+		wantOK     bool
+	}{
+		{name: "nil assessment", assessment: nil},
+		{name: "empty assessment", assessment: &Assessment{}},
+		{name: "missing market value", assessment: &Assessment{AssessedTotalValue: float(100000)}},
+		{name: "missing assessed value", assessment: &Assessment{MarketTotalValue: float(400000)}},
+		{name: "zero market value", assessment: &Assessment{AssessedTotalValue: float(100000), MarketTotalValue: float(0)}},
+		{name: "full assessment", assessment: &Assessment{AssessedTotalValue: float(100000), MarketTotalValue: float(400000)}, want: 0.25, wantOK: true},
+		{name: "over-assessed", assessment: &Assessment{AssessedTotalValue: float(330000), MarketTotalValue: float(300000)}, want: 1.1, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.assessment.AssessmentRatio()
+			if ok != tt.wantOK {
+				t.Fatalf("AssessmentRatio() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("AssessmentRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
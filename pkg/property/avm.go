@@ -0,0 +1,127 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// Best returns the AVM entry with the highest Score.
+//
+// Entries without a score rank below any scored entry. When scores tie, the
+// entry with the narrower High-Low range wins because it reflects a tighter
+// valuation; if that also ties, the entry appearing first in the response is
+// kept. The boolean is false when the response holds no AVM entries.
+func (r *AVMSnapshotResponse) Best() (*AVM, bool) {
+	if r == nil {
+		return nil, false
+	}
+	var best *AVM
+	for _, candidate := range r.AVM {
+		if candidate == nil {
+			continue
+		}
+		if best == nil || betterAVM(candidate, best) {
+			best = candidate
+		}
+	}
+	return best, best != nil
+}
+
+// betterAVM reports whether a should be preferred over b.
+func betterAVM(a, b *AVM) bool {
+	switch {
+	case a.Score == nil && b.Score == nil:
+		return false
+	case b.Score == nil:
+		return true
+	case a.Score == nil:
+		return false
+	case *a.Score != *b.Score:
+		return *a.Score > *b.Score
+	}
+	aRange, aOK := avmRange(a)
+	bRange, bOK := avmRange(b)
+	if aOK && bOK {
+		return aRange < bRange
+	}
+	return aOK && !bOK
+}
+
+// avmRange returns the width of the valuation range when both bounds are present.
+func avmRange(a *AVM) (float64, bool) {
+	if a.High == nil || a.Low == nil {
+		return 0, false
+	}
+	return *a.High - *a.Low, true
+}
+
+// AVMValueDistribution pages through GetAVMSnapshotGeo for geoIDV4 and counts AVM
+// values into the ranges delimited by buckets, which must be strictly ascending.
+// The keys are "<b0" for values below the first boundary, "b0-b1" for values in
+// [b0, b1), and so on, with ">=bn" for values at or above the last boundary; every
+// key is present even when its count is zero. Entries without a value are skipped.
+// Paging stops when a page comes back empty, the reported total has been read, or
+// after 100 pages. opts are applied to every page request, and WithPageSize may be
+// used to tune the page size.
+func (s *Service) AVMValueDistribution(ctx context.Context, geoIDV4 string, buckets []float64, opts ...Option) (map[string]int, error) {
+	if len(buckets) == 0 {
+		return nil, errors.New("property: at least one bucket boundary required")
+	}
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			return nil, errors.New("property: bucket boundaries must be strictly ascending")
+		}
+	}
+
+	labels := make([]string, len(buckets)+1)
+	labels[0] = "<" + formatBucket(buckets[0])
+	for i := 1; i < len(buckets); i++ {
+		labels[i] = formatBucket(buckets[i-1]) + "-" + formatBucket(buckets[i])
+	}
+	labels[len(buckets)] = ">=" + formatBucket(buckets[len(buckets)-1])
+	dist := make(map[string]int, len(labels))
+	for _, label := range labels {
+		dist[label] = 0
+	}
+
+	seen := 0
+	for page := 1; page <= maxAutoPages; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		pageOpts := append(append([]Option{}, opts...), WithPage(page))
+		resp, err := s.GetAVMSnapshotGeo(ctx, geoIDV4, "", "", "", pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.AVM) == 0 {
+			break
+		}
+		for _, avm := range resp.AVM {
+			if avm == nil || avm.Value == nil {
+				continue
+			}
+			dist[labels[bucketIndex(buckets, *avm.Value)]]++
+		}
+		seen += len(resp.AVM)
+		if resp.Status != nil && resp.Status.Total != nil && seen >= *resp.Status.Total {
+			break
+		}
+	}
+	return dist, nil
+}
+
+// bucketIndex returns the index of the label for v given ascending boundaries.
+func bucketIndex(buckets []float64, v float64) int {
+	for i, b := range buckets {
+		if v < b {
+			return i
+		}
+	}
+	return len(buckets)
+}
+
+func formatBucket(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
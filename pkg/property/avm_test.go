@@ -0,0 +1,148 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+func TestAVMSnapshotResponseBest(t *testing.T) {
+	score := func(v float64) *FlexFloat {
+		f := FlexFloat(v)
+		return &f
+	}
+	float := func(v float64) *float64 { return &v }
+
+	t.Run("nil response", func(t *testing.T) {
+		var r *AVMSnapshotResponse
+		if avm, ok := r.Best(); ok || avm != nil {
+			t.Errorf("expected no AVM for nil response")
+		}
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		r := &AVMSnapshotResponse{AVM: []*AVM{nil}}
+		if avm, ok := r.Best(); ok || avm != nil {
+			t.Errorf("expected no AVM for empty response")
+		}
+	})
+
+	t.Run("highest score wins", func(t *testing.T) {
+		low := &AVM{Value: float(100), Score: score(70)}
+		high := &AVM{Value: float(200), Score: score(92)}
+		mid := &AVM{Value: float(300), Score: score(85)}
+		r := &AVMSnapshotResponse{AVM: []*AVM{low, high, mid}}
+		got, ok := r.Best()
+		if !ok || got != high {
+			t.Errorf("expected highest-score AVM, got %+v", got)
+		}
+	})
+
+	t.Run("scored beats unscored", func(t *testing.T) {
+		unscored := &AVM{Value: float(100)}
+		scored := &AVM{Value: float(200), Score: score(10)}
+		r := &AVMSnapshotResponse{AVM: []*AVM{unscored, scored}}
+		got, _ := r.Best()
+		if got != scored {
+			t.Errorf("expected scored AVM, got %+v", got)
+		}
+	})
+
+	t.Run("all unscored returns first", func(t *testing.T) {
+		first := &AVM{Value: float(100)}
+		second := &AVM{Value: float(200)}
+		r := &AVMSnapshotResponse{AVM: []*AVM{first, second}}
+		got, ok := r.Best()
+		if !ok || got != first {
+			t.Errorf("expected first AVM, got %+v", got)
+		}
+	})
+
+	t.Run("tie broken by narrower range", func(t *testing.T) {
+		wide := &AVM{Score: score(90), High: float(400), Low: float(100)}
+		narrow := &AVM{Score: score(90), High: float(260), Low: float(240)}
+		r := &AVMSnapshotResponse{AVM: []*AVM{wide, narrow}}
+		got, _ := r.Best()
+		if got != narrow {
+			t.Errorf("expected narrower-range AVM, got %+v", got)
+		}
+	})
+
+	t.Run("full tie keeps first", func(t *testing.T) {
+		first := &AVM{Score: score(90)}
+		second := &AVM{Score: score(90)}
+		r := &AVMSnapshotResponse{AVM: []*AVM{first, second}}
+		got, _ := r.Best()
+		if got != first {
+			t.Errorf("expected first AVM on full tie, got %+v", got)
+		}
+	})
+}
+
+func TestAVMValueDistribution(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"status":{"total":5,"page":1,"pagesize":3},"avm":[{"value":150000},{"value":250000},{"value":450000}]}`,
+		"2": `{"status":{"total":5,"page":2,"pagesize":3},"avm":[{"value":200000},{},{"value":999999}]}`,
+	}
+	var requested []string
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		q := req.URL.Query()
+		if q.Get("geoIdV4") != "abc123" || q.Get("pagesize") != "3" {
+			t.Errorf("unexpected query %v", q)
+		}
+		page := q.Get("page")
+		requested = append(requested, page)
+		body, ok := pages[page]
+		if !ok {
+			t.Fatalf("unexpected page %q", page)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	got, err := svc.AVMValueDistribution(context.Background(), "abc123", []float64{200000, 400000}, WithPageSize(3))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]int{"<200000": 1, "200000-400000": 2, ">=400000": 2}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("bucket %q: expected %d, got %d", k, v, got[k])
+		}
+	}
+	if strings.Join(requested, ",") != "1,2" {
+		t.Errorf("expected pages 1,2 to be requested, got %v", requested)
+	}
+}
+
+func TestAVMValueDistributionErrors(t *testing.T) {
+	svc := NewService(client.New("test-key", nil, client.WithBaseURL("https://example.com/")))
+
+	t.Run("no buckets", func(t *testing.T) {
+		if _, err := svc.AVMValueDistribution(context.Background(), "abc123", nil); err == nil {
+			t.Error("expected error for empty buckets")
+		}
+	})
+
+	t.Run("unsorted buckets", func(t *testing.T) {
+		if _, err := svc.AVMValueDistribution(context.Background(), "abc123", []float64{5, 5}); err == nil {
+			t.Error("expected error for non-ascending buckets")
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := svc.AVMValueDistribution(ctx, "abc123", []float64{1}); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
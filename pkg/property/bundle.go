@@ -0,0 +1,87 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// GetPropertyProfileBundle concurrently fetches the property detail, assessment
+// detail, AVM snapshot, and sale detail for the property identified by opts and
+// merges them into a ProfileBundle. The AVM is the snapshot's Best entry and the
+// sale is the one with the latest sale date. When some requests fail the bundle
+// still holds the results of the others, and the failures are returned joined,
+// each prefixed with the request it came from.
+func (s *Service) GetPropertyProfileBundle(ctx context.Context, opts ...Option) (*ProfileBundle, error) {
+	if err := requirePropertyIdentifier(s.applyOptions(opts)); err != nil {
+		return nil, err
+	}
+
+	var (
+		wg                                        sync.WaitGroup
+		detail                                    *DetailResponse
+		assessment                                *AssessmentDetailResponse
+		avm                                       *AVMSnapshotResponse
+		sale                                      *SaleDetailResponse
+		detailErr, assessmentErr, avmErr, saleErr error
+	)
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		detail, detailErr = s.GetPropertyDetail(ctx, opts...)
+	}()
+	go func() {
+		defer wg.Done()
+		assessment, assessmentErr = s.GetAssessmentDetail(ctx, opts...)
+	}()
+	go func() {
+		defer wg.Done()
+		avm, avmErr = s.GetAVMSnapshot(ctx, opts...)
+	}()
+	go func() {
+		defer wg.Done()
+		sale, saleErr = s.GetSaleDetail(ctx, opts...)
+	}()
+	wg.Wait()
+
+	bundle := &ProfileBundle{}
+	if detailErr != nil {
+		detailErr = fmt.Errorf("property: property detail: %w", detailErr)
+	} else if len(detail.Property) > 0 && detail.Property[0] != nil {
+		bundle.Identifier = detail.Property[0].Identifier
+		bundle.Address = detail.Property[0].Address
+	}
+	if assessmentErr != nil {
+		assessmentErr = fmt.Errorf("property: assessment detail: %w", assessmentErr)
+	} else if len(assessment.Assessment) > 0 {
+		bundle.Assessment = assessment.Assessment[0]
+	}
+	if avmErr != nil {
+		avmErr = fmt.Errorf("property: avm snapshot: %w", avmErr)
+	} else {
+		bundle.AVM, _ = avm.Best()
+	}
+	if saleErr != nil {
+		saleErr = fmt.Errorf("property: sale detail: %w", saleErr)
+	} else {
+		bundle.Sale = latestSale(sale.Sale)
+	}
+	return bundle, errors.Join(detailErr, assessmentErr, avmErr, saleErr)
+}
+
+// latestSale returns the sale with the greatest SaleDate. ATTOM dates are ISO
+// formatted, so they order lexically. Sales without a date rank below dated
+// ones, and on ties the first sale in the response is kept.
+func latestSale(sales []*Sale) *Sale {
+	var latest *Sale
+	for _, candidate := range sales {
+		if candidate == nil {
+			continue
+		}
+		if latest == nil || candidate.GetSaleDate() > latest.GetSaleDate() {
+			latest = candidate
+		}
+	}
+	return latest
+}
@@ -0,0 +1,113 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+func TestGetPropertyProfileBundle(t *testing.T) {
+	const (
+		// Property and assessment detail share the v4/property/detail endpoint.
+		detailBody = `{"status":{},
+			"property":[{"identifier":{"attomId":"100"},"address":{"line1":"1 Main St","city":"Denver"}}],
+			"assessment":[{"assdTtlValue":300000,"taxYear":2024}]}`
+		avmBody   = `{"status":{},"avm":[{"value":500000,"score":70},{"value":510000,"score":90}]}`
+		saleBody  = `{"status":{},"sale":[{"saleDate":"2015-03-01","amount":250000},{"saleDate":"2021-06-15","amount":410000},{"amount":1}]}`
+		errorBody = `{"status":{"msg":"server error"}}`
+	)
+	newService := func(t *testing.T, failing string, calls *int32) *Service {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(calls, 1)
+			if got := req.URL.Query().Get("attomid"); got != "100" {
+				t.Errorf("%s: expected attomid 100, got %q", req.URL.Path, got)
+			}
+			code, body := http.StatusOK, ""
+			switch req.URL.Path {
+			case "/v4/property/detail":
+				body = detailBody
+			case "/v4/property/avm/snapshot":
+				body = avmBody
+			case "/v4/transaction/detail":
+				body = saleBody
+			default:
+				t.Errorf("unexpected path %s", req.URL.Path)
+			}
+			if req.URL.Path == failing {
+				code, body = http.StatusInternalServerError, errorBody
+			}
+			return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+		})
+		return NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	}
+	ctx := context.Background()
+
+	t.Run("all succeed", func(t *testing.T) {
+		var calls int32
+		bundle, err := newService(t, "", &calls).GetPropertyProfileBundle(ctx, WithAttomID("100"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 4 {
+			t.Errorf("expected 4 requests, got %d", calls)
+		}
+		if bundle.GetIdentifier().GetAttomID() != "100" || bundle.GetAddress().GetCity() != "Denver" {
+			t.Errorf("unexpected identifier/address: %+v %+v", bundle.Identifier, bundle.Address)
+		}
+		if bundle.GetAssessment().GetTaxYear() != 2024 {
+			t.Errorf("expected assessment tax year 2024, got %+v", bundle.Assessment)
+		}
+		if bundle.GetAVM().GetValue() != 510000 {
+			t.Errorf("expected best AVM 510000, got %+v", bundle.AVM)
+		}
+		if bundle.GetSale().GetSaleDate() != "2021-06-15" {
+			t.Errorf("expected latest sale, got %+v", bundle.Sale)
+		}
+	})
+
+	t.Run("partial failure", func(t *testing.T) {
+		var calls int32
+		bundle, err := newService(t, "/v4/transaction/detail", &calls).GetPropertyProfileBundle(ctx, WithAttomID("100"))
+		if err == nil || !strings.Contains(err.Error(), "sale detail") {
+			t.Fatalf("expected sale detail error, got %v", err)
+		}
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected *Error in joined error, got %v", err)
+		}
+		if bundle == nil || bundle.Sale != nil {
+			t.Fatalf("expected bundle without sale, got %+v", bundle)
+		}
+		if bundle.GetIdentifier().GetAttomID() != "100" || bundle.AVM == nil || bundle.Assessment == nil {
+			t.Errorf("expected successful parts to be merged, got %+v", bundle)
+		}
+	})
+
+	t.Run("missing identifier", func(t *testing.T) {
+		var calls int32
+		_, err := newService(t, "", &calls).GetPropertyProfileBundle(ctx)
+		if !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("expected no requests, got %d", calls)
+		}
+	})
+}
+
+func TestLatestSale(t *testing.T) {
+	date := func(v string) *Sale { return &Sale{SaleDate: &v} }
+	first, second := date("2020-01-01"), date("2020-01-01")
+	if got := latestSale([]*Sale{nil, {}, first, second}); got != first {
+		t.Errorf("expected first of tied sales, got %+v", got)
+	}
+	if got := latestSale(nil); got != nil {
+		t.Errorf("expected nil, got %+v", got)
+	}
+}
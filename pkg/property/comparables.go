@@ -0,0 +1,172 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ComparablesCriteria holds the tuning parameters accepted in the JSON request
+// body of the sales comparables endpoint. Nil fields are omitted so ATTOM applies
+// its defaults.
+type ComparablesCriteria struct {
+	SearchRadius       *float64 `json:"searchRadius,omitempty"`
+	MinComps           *int     `json:"minComps,omitempty"`
+	MaxComps           *int     `json:"maxComps,omitempty"`
+	SaleDateFrom       *string  `json:"saleDateFrom,omitempty"`
+	SaleDateTo         *string  `json:"saleDateTo,omitempty"`
+	LivingAreaVariance *float64 `json:"livingAreaVariance,omitempty"`
+}
+
+// validate checks that the criteria are internally consistent.
+func (c ComparablesCriteria) validate() error {
+	if c.SearchRadius != nil && *c.SearchRadius <= 0 {
+		return fmt.Errorf("property: search radius must be positive, got %v", *c.SearchRadius)
+	}
+	if c.MinComps != nil && c.MaxComps != nil && *c.MinComps > *c.MaxComps {
+		return fmt.Errorf("property: min comps %d exceeds max comps %d", *c.MinComps, *c.MaxComps)
+	}
+	if c.LivingAreaVariance != nil && *c.LivingAreaVariance < 0 {
+		return fmt.Errorf("property: living area variance must not be negative, got %v", *c.LivingAreaVariance)
+	}
+	if c.SaleDateFrom != nil && c.SaleDateTo != nil && *c.SaleDateFrom > *c.SaleDateTo {
+		return fmt.Errorf("property: sale date from %s is after sale date to %s", *c.SaleDateFrom, *c.SaleDateTo)
+	}
+	return nil
+}
+
+// comparablesDateLayout is the date format of the comparables sale-date window.
+const comparablesDateLayout = "2006-01-02"
+
+// WithComparableSearchRadius sets the searchRadius parameter, in miles, of the
+// sales comparables endpoints. Non-positive values are ignored.
+func WithComparableSearchRadius(miles float64) Option {
+	return func(values url.Values) {
+		if miles > 0 {
+			values.Set("searchRadius", strconv.FormatFloat(miles, 'f', -1, 64))
+		}
+	}
+}
+
+// WithComparableCount sets the minComps and maxComps parameters bounding how many
+// comparables are returned. Non-positive values are ignored. The comparables
+// methods reject a minimum greater than the maximum.
+func WithComparableCount(minComps, maxComps int) Option {
+	return func(values url.Values) {
+		if minComps > 0 {
+			values.Set("minComps", strconv.Itoa(minComps))
+		}
+		if maxComps > 0 {
+			values.Set("maxComps", strconv.Itoa(maxComps))
+		}
+	}
+}
+
+// WithComparableSaleDateRange sets the saleDateFrom and saleDateTo parameters,
+// formatted as YYYY-MM-DD, limiting comparables to sales in the window. Zero
+// times are ignored. The comparables methods reject a start after the end.
+func WithComparableSaleDateRange(start, end time.Time) Option {
+	return func(values url.Values) {
+		if !start.IsZero() {
+			values.Set("saleDateFrom", start.Format(comparablesDateLayout))
+		}
+		if !end.IsZero() {
+			values.Set("saleDateTo", end.Format(comparablesDateLayout))
+		}
+	}
+}
+
+// WithComparableLivingAreaVariance sets the livingAreaVariance parameter, the
+// allowed difference in living area between the subject and its comparables as a
+// percentage. Negative values are ignored.
+func WithComparableLivingAreaVariance(percent float64) Option {
+	return func(values url.Values) {
+		if percent >= 0 {
+			values.Set("livingAreaVariance", strconv.FormatFloat(percent, 'f', -1, 64))
+		}
+	}
+}
+
+// validateComparablesParams applies the ComparablesCriteria checks to the
+// tuning parameters set by the WithComparable options.
+func validateComparablesParams(values url.Values) error {
+	var criteria ComparablesCriteria
+	if v := values.Get("searchRadius"); v != "" {
+		radius, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("property: invalid searchRadius %q", v)
+		}
+		criteria.SearchRadius = &radius
+	}
+	var err error
+	if criteria.MinComps, err = intParam(values, "minComps"); err != nil {
+		return err
+	}
+	if criteria.MaxComps, err = intParam(values, "maxComps"); err != nil {
+		return err
+	}
+	if v := values.Get("saleDateFrom"); v != "" {
+		criteria.SaleDateFrom = &v
+	}
+	if v := values.Get("saleDateTo"); v != "" {
+		criteria.SaleDateTo = &v
+	}
+	return criteria.validate()
+}
+
+// postSaleComparables validates criteria and POSTs it to endpoint. Path segments
+// are escaped by client.NewRequest.
+func (s *Service) postSaleComparables(ctx context.Context, endpoint string, criteria ComparablesCriteria) (*SaleComparablesResponse, error) {
+	if err := criteria.validate(); err != nil {
+		return nil, err
+	}
+	var resp SaleComparablesResponse
+	if err := s.doPost(ctx, endpoint, s.applyOptions(nil), criteria, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSaleComparablesByAddressWithCriteria retrieves sale comparables by address,
+// sending criteria as the JSON request body of a POST request.
+func (s *Service) GetSaleComparablesByAddressWithCriteria(ctx context.Context, street, city, county, state, zip string, criteria ComparablesCriteria) (*SaleComparablesResponse, error) {
+	if street == "" || city == "" || county == "" || state == "" || zip == "" {
+		return nil, fmt.Errorf("%w: address components required", ErrMissingParameter)
+	}
+	endpoint := fmt.Sprintf("%saddress/%s/%s/%s/%s/%s", saleComparablesBasePath, street, city, county, state, zip)
+	return s.postSaleComparables(ctx, endpoint, criteria)
+}
+
+// GetSaleComparablesByAPNWithCriteria retrieves sale comparables by APN, sending
+// criteria as the JSON request body of a POST request.
+func (s *Service) GetSaleComparablesByAPNWithCriteria(ctx context.Context, apn, county, state string, criteria ComparablesCriteria) (*SaleComparablesResponse, error) {
+	if apn == "" || county == "" || state == "" {
+		return nil, fmt.Errorf("%w: APN, county, and state required", ErrMissingParameter)
+	}
+	endpoint := fmt.Sprintf("%sapn/%s/%s/%s", saleComparablesBasePath, apn, county, state)
+	return s.postSaleComparables(ctx, endpoint, criteria)
+}
+
+// GetSaleComparablesByPropIDWithCriteria retrieves sale comparables by property
+// ID, sending criteria as the JSON request body of a POST request.
+func (s *Service) GetSaleComparablesByPropIDWithCriteria(ctx context.Context, propID string, criteria ComparablesCriteria) (*SaleComparablesResponse, error) {
+	if propID == "" {
+		return nil, fmt.Errorf("%w: property ID required", ErrMissingParameter)
+	}
+	return s.postSaleComparables(ctx, saleComparablesBasePath+"propid/"+propID, criteria)
+}
+
+// intParam parses the integer parameter key, returning nil when it is absent.
+func intParam(values url.Values, key string) (*int, error) {
+	v := values.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("property: invalid %s %q", key, v)
+	}
+	return &n, nil
+}
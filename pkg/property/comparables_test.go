@@ -0,0 +1,207 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+func TestGetSaleComparablesWithCriteria(t *testing.T) {
+	radius, variance := 1.5, 20.0
+	minComps, maxComps := 3, 10
+	from, to := "2024-01-01", "2024-12-31"
+	criteria := ComparablesCriteria{
+		SearchRadius:       &radius,
+		MinComps:           &minComps,
+		MaxComps:           &maxComps,
+		SaleDateFrom:       &from,
+		SaleDateTo:         &to,
+		LivingAreaVariance: &variance,
+	}
+	wantBody := map[string]any{
+		"searchRadius":       1.5,
+		"minComps":           float64(3),
+		"maxComps":           float64(10),
+		"saleDateFrom":       "2024-01-01",
+		"saleDateTo":         "2024-12-31",
+		"livingAreaVariance": float64(20),
+	}
+
+	newService := func(wantPath string) *Service {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", req.Method)
+			}
+			if req.URL.EscapedPath() != wantPath {
+				t.Errorf("expected path %s, got %s", wantPath, req.URL.EscapedPath())
+			}
+			if got := req.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("expected JSON content type, got %q", got)
+			}
+			var got map[string]any
+			if err := json.NewDecoder(req.Body).Decode(&got); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if !reflect.DeepEqual(got, wantBody) {
+				t.Errorf("request body = %v, want %v", got, wantBody)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader(`{"saleComparable":[{"propertyId":"200"}]}`)),
+			}, nil
+		})
+		return NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	}
+	ctx := context.Background()
+
+	t.Run("by prop ID", func(t *testing.T) {
+		resp, err := newService("/property/v2/salescomparables/propid/100").GetSaleComparablesByPropIDWithCriteria(ctx, "100", criteria)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.SaleComparables) != 1 || *resp.SaleComparables[0].PropertyID != "200" {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+
+	t.Run("by APN", func(t *testing.T) {
+		if _, err := newService("/property/v2/salescomparables/apn/123-45/Orange/CA").GetSaleComparablesByAPNWithCriteria(ctx, "123-45", "Orange", "CA", criteria); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("by address", func(t *testing.T) {
+		if _, err := newService("/property/v2/salescomparables/address/1%20Main%20St/Irvine/Orange/CA/92618").GetSaleComparablesByAddressWithCriteria(ctx, "1 Main St", "Irvine", "Orange", "CA", "92618", criteria); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("empty criteria sends empty object", func(t *testing.T) {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				t.Fatalf("read request body: %v", err)
+			}
+			if string(body) != "{}" {
+				t.Errorf("expected {}, got %s", body)
+			}
+			return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		if _, err := svc.GetSaleComparablesByPropIDWithCriteria(ctx, "100", ComparablesCriteria{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		svc := newService("")
+		if _, err := svc.GetSaleComparablesByPropIDWithCriteria(ctx, "", criteria); !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+		if _, err := svc.GetSaleComparablesByAPNWithCriteria(ctx, "1", "", "CA", criteria); !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+		inverted := criteria
+		inverted.MinComps, inverted.MaxComps = &maxComps, &minComps
+		if _, err := svc.GetSaleComparablesByPropIDWithCriteria(ctx, "100", inverted); err == nil {
+			t.Error("expected error when min comps exceeds max comps")
+		}
+		negative := -1.0
+		if _, err := svc.GetSaleComparablesByPropIDWithCriteria(ctx, "100", ComparablesCriteria{SearchRadius: &negative}); err == nil {
+			t.Error("expected error for negative radius")
+		}
+	})
+}
+
+func TestComparableOptions(t *testing.T) {
+	vals := url.Values{}
+	for _, opt := range []Option{
+		WithComparableSearchRadius(2.5),
+		WithComparableCount(3, 12),
+		WithComparableSaleDateRange(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)),
+		WithComparableLivingAreaVariance(15),
+	} {
+		opt(vals)
+	}
+	want := url.Values{
+		"searchRadius":       {"2.5"},
+		"minComps":           {"3"},
+		"maxComps":           {"12"},
+		"saleDateFrom":       {"2024-01-01"},
+		"saleDateTo":         {"2024-06-30"},
+		"livingAreaVariance": {"15"},
+	}
+	if !reflect.DeepEqual(vals, want) {
+		t.Errorf("expected %v, got %v", want, vals)
+	}
+
+	t.Run("ignored values", func(t *testing.T) {
+		vals := url.Values{}
+		WithComparableSearchRadius(0)(vals)
+		WithComparableCount(0, -1)(vals)
+		WithComparableSaleDateRange(time.Time{}, time.Time{})(vals)
+		WithComparableLivingAreaVariance(-5)(vals)
+		if len(vals) != 0 {
+			t.Errorf("expected no parameters, got %v", vals)
+		}
+	})
+}
+
+func TestValidateComparablesParams(t *testing.T) {
+	tests := []struct {
+		opt     Option
+		name    string
+		wantErr string
+	}{
+		{name: "valid", opt: WithComparableCount(3, 10)},
+		{name: "min only", opt: WithComparableCount(5, 0)},
+		{name: "min exceeds max", opt: WithComparableCount(10, 3), wantErr: "min comps 10 exceeds max comps 3"},
+		{name: "inverted sale dates", opt: WithComparableSaleDateRange(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), wantErr: "sale date from"},
+		{name: "malformed count", opt: WithString("maxComps", "many"), wantErr: "invalid maxComps"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := url.Values{}
+			tt.opt(vals)
+			err := validateComparablesParams(vals)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("comparables methods reject min greater than max", func(t *testing.T) {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			t.Errorf("unexpected request to %s", req.URL)
+			return nil, errors.New("unexpected request")
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		ctx := context.Background()
+		opt := WithComparableCount(10, 3)
+		if _, err := svc.GetSaleComparablesByPropID(ctx, "100", opt); err == nil {
+			t.Errorf("GetSaleComparablesByPropID: expected error")
+		}
+		if _, err := svc.GetSaleComparablesByAPN(ctx, "123", "Denver", "CO", opt); err == nil {
+			t.Errorf("GetSaleComparablesByAPN: expected error")
+		}
+		if _, err := svc.GetSaleComparablesByAddress(ctx, "1 Main St", "Denver", "Denver", "CO", "80202", opt); err == nil {
+			t.Errorf("GetSaleComparablesByAddress: expected error")
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package property
+
+// kilometersPerMile is the number of kilometers in an international mile.
+const kilometersPerMile = 1.609344
+
+// MilesToKilometers converts a distance in miles to kilometers.
+func MilesToKilometers(miles float64) float64 {
+	return miles * kilometersPerMile
+}
+
+// KilometersToMiles converts a distance in kilometers to miles, the unit ATTOM
+// uses for radius parameters and response distances.
+func KilometersToMiles(km float64) float64 {
+	return km / kilometersPerMile
+}
+
+// DistanceKilometers returns DistanceInMiles converted to kilometers. The
+// boolean is false when the school or its distance is missing.
+func (s *School) DistanceKilometers() (float64, bool) {
+	if s == nil || s.DistanceInMiles == nil {
+		return 0, false
+	}
+	return MilesToKilometers(*s.DistanceInMiles), true
+}
+
+// DistanceKilometers returns Distance, reported by ATTOM in miles, converted to
+// kilometers. The boolean is false when the POI or its distance is missing.
+func (p *POI) DistanceKilometers() (float64, bool) {
+	if p == nil || p.Distance == nil {
+		return 0, false
+	}
+	return MilesToKilometers(*p.Distance), true
+}
+
+// DistanceKilometers returns Distance, reported by ATTOM in miles, converted to
+// kilometers. The boolean is false when the comparable or its distance is missing.
+func (s *SaleComparable) DistanceKilometers() (float64, bool) {
+	if s == nil || s.Distance == nil {
+		return 0, false
+	}
+	return MilesToKilometers(*s.Distance), true
+}
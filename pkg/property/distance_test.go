@@ -0,0 +1,47 @@
+package property
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistanceConversion(t *testing.T) {
+	if got := MilesToKilometers(1); got != 1.609344 {
+		t.Errorf("MilesToKilometers(1) = %v", got)
+	}
+	if got := KilometersToMiles(100); math.Abs(got-62.13711922373339) > 1e-9 {
+		t.Errorf("KilometersToMiles(100) = %v", got)
+	}
+	if got := KilometersToMiles(MilesToKilometers(3.5)); math.Abs(got-3.5) > 1e-12 {
+		t.Errorf("round trip = %v", got)
+	}
+}
+
+func TestDistanceKilometers(t *testing.T) {
+	miles := 2.5
+	want := 4.02336
+
+	tests := []struct {
+		get  func() (float64, bool)
+		name string
+		ok   bool
+	}{
+		{name: "school", get: (&School{DistanceInMiles: &miles}).DistanceKilometers, ok: true},
+		{name: "poi", get: (&POI{Distance: &miles}).DistanceKilometers, ok: true},
+		{name: "sale comparable", get: (&SaleComparable{Distance: &miles}).DistanceKilometers, ok: true},
+		{name: "school without distance", get: (&School{}).DistanceKilometers},
+		{name: "nil poi", get: (*POI)(nil).DistanceKilometers},
+		{name: "nil sale comparable", get: (*SaleComparable)(nil).DistanceKilometers},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.get()
+			if ok != tt.ok {
+				t.Fatalf("expected ok=%v, got %v", tt.ok, ok)
+			}
+			if ok && math.Abs(got-want) > 1e-9 {
+				t.Errorf("expected %v km, got %v", want, got)
+			}
+		})
+	}
+}
@@ -14,6 +14,14 @@ const (
 	FormatWKT     = "wkt"
 )
 
+// TileFormat represents valid parcel tile formats, used as the file extension
+// of the tile path.
+const (
+	TileFormatPNG = "png"
+	TileFormatMVT = "mvt"
+	TileFormatPBF = "pbf"
+)
+
 // PropertyType represents valid property type classifications.
 // These values can be used with the propertytype parameter in various endpoints.
 const (
@@ -80,6 +88,40 @@ const (
 	OrderByLotSize2            = "lotsize2"
 )
 
+// AggregationLevel identifies the geography at which trend data is aggregated.
+type AggregationLevel string
+
+// AggregationLevel values accepted by the trend endpoints.
+const (
+	AggregationLevelCounty AggregationLevel = "county"
+	AggregationLevelZip    AggregationLevel = "zip"
+	AggregationLevelTract  AggregationLevel = "tract"
+)
+
+// TrendInterval identifies the period over which trend data is bucketed.
+type TrendInterval string
+
+// TrendInterval values accepted by the trend endpoints.
+const (
+	TrendIntervalMonthly   TrendInterval = "monthly"
+	TrendIntervalQuarterly TrendInterval = "quarterly"
+	TrendIntervalYearly    TrendInterval = "yearly"
+)
+
+// MatchQuality describes the positional precision of a geocoded location, from
+// most precise (rooftop) to least precise (ZIP centroid).
+type MatchQuality string
+
+// MatchQuality values derived from ATTOM matchCode/accuracy fields.
+const (
+	MatchQualityRooftop MatchQuality = "Rooftop"
+	MatchQualityStreet  MatchQuality = "Street"
+	MatchQualityZip9    MatchQuality = "Zip9"
+	MatchQualityZip7    MatchQuality = "Zip7"
+	MatchQualityZip5    MatchQuality = "Zip5"
+	MatchQualityUnknown MatchQuality = "Unknown"
+)
+
 // ValidateAcceptHeader checks if the provided accept header value is valid.
 func ValidateAcceptHeader(accept string) error {
 	switch accept {
@@ -90,6 +132,16 @@ func ValidateAcceptHeader(accept string) error {
 	}
 }
 
+// ValidateTileFormat checks if the provided parcel tile format is valid.
+func ValidateTileFormat(format string) error {
+	switch format {
+	case TileFormatPNG, TileFormatMVT, TileFormatPBF:
+		return nil
+	default:
+		return fmt.Errorf("invalid tile format: %q (must be %q, %q, or %q)", format, TileFormatPNG, TileFormatMVT, TileFormatPBF)
+	}
+}
+
 // ValidateFormat checks if the provided format value is valid.
 func ValidateFormat(format string) error {
 	switch format {
@@ -181,3 +233,23 @@ func ValidateOrderBy(orderBy string) error {
 	}
 	return fmt.Errorf("invalid orderby: %q", orderBy)
 }
+
+// ValidateAggregationLevel checks if the provided aggregation level is valid.
+func ValidateAggregationLevel(level AggregationLevel) error {
+	switch level {
+	case AggregationLevelCounty, AggregationLevelZip, AggregationLevelTract:
+		return nil
+	default:
+		return fmt.Errorf("invalid aggregation level: %q (must be %q, %q, or %q)", level, AggregationLevelCounty, AggregationLevelZip, AggregationLevelTract)
+	}
+}
+
+// ValidateTrendInterval checks if the provided trend interval is valid.
+func ValidateTrendInterval(interval TrendInterval) error {
+	switch interval {
+	case TrendIntervalMonthly, TrendIntervalQuarterly, TrendIntervalYearly:
+		return nil
+	default:
+		return fmt.Errorf("invalid trend interval: %q (must be %q, %q, or %q)", interval, TrendIntervalMonthly, TrendIntervalQuarterly, TrendIntervalYearly)
+	}
+}
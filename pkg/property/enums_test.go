@@ -81,6 +81,19 @@ func TestValidateFormat(t *testing.T) {
 	}
 }
 
+func TestValidateTileFormat(t *testing.T) {
+	for _, format := range []string{TileFormatPNG, TileFormatMVT, TileFormatPBF} {
+		if err := ValidateTileFormat(format); err != nil {
+			t.Errorf("ValidateTileFormat(%q) error = %v", format, err)
+		}
+	}
+	for _, format := range []string{"", "PNG", "json"} {
+		if err := ValidateTileFormat(format); err == nil {
+			t.Errorf("ValidateTileFormat(%q) expected error", format)
+		}
+	}
+}
+
 func TestValidatePropertyType(t *testing.T) {
 	validTypes := []string{
 		PropertyTypeAgriculturalNEC,
@@ -295,3 +308,51 @@ func TestValidateFIPSAndAPN(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAggregationLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   AggregationLevel
+		wantErr bool
+	}{
+		{name: "county", level: AggregationLevelCounty},
+		{name: "zip", level: AggregationLevelZip},
+		{name: "tract", level: AggregationLevelTract},
+		{name: "invalid", level: "planet", wantErr: true},
+		{name: "wrong case", level: "County", wantErr: true},
+		{name: "empty string", level: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAggregationLevel(tt.level)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAggregationLevel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTrendInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval TrendInterval
+		wantErr  bool
+	}{
+		{name: "monthly", interval: TrendIntervalMonthly},
+		{name: "quarterly", interval: TrendIntervalQuarterly},
+		{name: "yearly", interval: TrendIntervalYearly},
+		{name: "invalid", interval: "weekly", wantErr: true},
+		{name: "wrong case", interval: "Monthly", wantErr: true},
+		{name: "empty string", interval: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTrendInterval(tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTrendInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
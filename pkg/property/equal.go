@@ -0,0 +1,182 @@
+package property
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// EqualProperty reports whether a and b encode to the same JSON, and if not,
+// describes the first difference found, such as
+// `property.address.city: "Denver" != "Boulder"`. Keys are compared in
+// sorted order. A missing or nil field is treated as equal to its zero value, so
+// a nil pointer matches a pointer to "" or 0, a nil *Property matches an empty
+// one, and a nil block matches a block whose fields are all unset. Numbers are
+// compared by value, so 1250 and 1250.0 are equal.
+func EqualProperty(a, b *Property) (bool, string) {
+	av, err := jsonValue(a)
+	if err != nil {
+		return false, fmt.Sprintf("property: encoding a: %v", err)
+	}
+	bv, err := jsonValue(b)
+	if err != nil {
+		return false, fmt.Sprintf("property: encoding b: %v", err)
+	}
+	if diff := firstJSONDiff("property", av, bv); diff != "" {
+		return false, diff
+	}
+	return true, ""
+}
+
+// jsonValue round-trips p through JSON into maps, slices, and json.Numbers.
+func jsonValue(p *Property) (interface{}, error) {
+	if p == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// firstJSONDiff returns a description of the first difference between two decoded
+// JSON values, or "" when they are equal up to zero values.
+func firstJSONDiff(path string, a, b interface{}) string {
+	if isZeroJSON(a) && isZeroJSON(b) {
+		return ""
+	}
+	// Walk into a block that is missing on one side, so the message names the
+	// first field that is set rather than the whole block.
+	a, b = emptyLike(a, b), emptyLike(b, a)
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(av)+len(bv))
+		for k := range av {
+			keys = append(keys, k)
+		}
+		for k := range bv {
+			if _, dup := av[k]; !dup {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if diff := firstJSONDiff(path+"."+k, av[k], bv[k]); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		n := len(av)
+		if len(bv) > n {
+			n = len(bv)
+		}
+		for i := 0; i < n; i++ {
+			var ai, bi interface{}
+			if i < len(av) {
+				ai = av[i]
+			}
+			if i < len(bv) {
+				bi = bv[i]
+			}
+			if diff := firstJSONDiff(fmt.Sprintf("%s[%d]", path, i), ai, bi); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	case json.Number:
+		if bv, ok := b.(json.Number); ok && numbersEqual(av, bv) {
+			return ""
+		}
+	default:
+		if a == b {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s: %s != %s", path, formatJSON(a), formatJSON(b))
+}
+
+// emptyLike returns an empty map or slice in place of a nil v when other is a
+// map or slice, and v unchanged otherwise.
+func emptyLike(v, other interface{}) interface{} {
+	if v != nil {
+		return v
+	}
+	switch other.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	case []interface{}:
+		return []interface{}{}
+	}
+	return nil
+}
+
+// isZeroJSON reports whether v is null, "", 0, false, or a map or slice whose
+// values are all zero.
+func isZeroJSON(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case bool:
+		return !t
+	case json.Number:
+		f, err := t.Float64()
+		return err == nil && f == 0
+	case map[string]interface{}:
+		for _, e := range t {
+			if !isZeroJSON(e) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		for _, e := range t {
+			if !isZeroJSON(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// numbersEqual compares two JSON numbers by value.
+func numbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	af, aerr := strconv.ParseFloat(a.String(), 64)
+	bf, berr := strconv.ParseFloat(b.String(), 64)
+	return aerr == nil && berr == nil && af == bf
+}
+
+// formatJSON renders a decoded JSON value for a difference message.
+func formatJSON(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,57 @@
+package property
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEqualProperty(t *testing.T) {
+	decode := func(t *testing.T, body string) *Property {
+		t.Helper()
+		var p Property
+		if err := json.Unmarshal([]byte(body), &p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return &p
+	}
+	const body = `{"identifier":{"attomId":"100"},"address":{"city":"Denver","latitude":39.74},"mortgage":[{"loanAmount":320000}]}`
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		a, b      *Property
+		name      string
+		wantDiff  string
+		wantEqual bool
+	}{
+		{name: "identical", a: decode(t, body), b: decode(t, body), wantEqual: true},
+		{name: "both nil", wantEqual: true},
+		{name: "nil and empty", a: nil, b: &Property{}, wantEqual: true},
+		{name: "nil and zero pointers", a: &Property{}, b: &Property{Address: &Address{City: str("")}}, wantEqual: true},
+		{
+			name:     "differing field",
+			a:        decode(t, body),
+			b:        decode(t, `{"identifier":{"attomId":"100"},"address":{"city":"Boulder","latitude":39.74},"mortgage":[{"loanAmount":320000}]}`),
+			wantDiff: `property.address.city: "Denver" != "Boulder"`,
+		},
+		{
+			name:     "differing slice element",
+			a:        decode(t, body),
+			b:        decode(t, `{"identifier":{"attomId":"100"},"address":{"city":"Denver","latitude":39.74},"mortgage":[{"loanAmount":320000},{"loanAmount":5}]}`),
+			wantDiff: `property.mortgage[1].loanAmount: <unset> != 5`,
+		},
+		{
+			name:     "nil and set",
+			a:        nil,
+			b:        &Property{Address: &Address{City: str("Denver")}},
+			wantDiff: `property.address.city: <unset> != "Denver"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diff := EqualProperty(tt.a, tt.b)
+			if equal != tt.wantEqual || diff != tt.wantDiff {
+				t.Errorf("expected (%v, %q), got (%v, %q)", tt.wantEqual, tt.wantDiff, equal, diff)
+			}
+		})
+	}
+}
@@ -9,24 +9,92 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 // ErrMissingParameter indicates that a required parameter was not supplied for a request.
 var ErrMissingParameter = errors.New("property: missing required parameter")
 
-// Error represents an ATTOM Property API error response.
+// ErrCountyNotFound is returned by CountyFIPS when no county matches the supplied name.
+var ErrCountyNotFound = errors.New("property: county not found")
+
+// ErrAmbiguousCounty is returned by CountyFIPS when a name matches several counties.
+var ErrAmbiguousCounty = errors.New("property: ambiguous county name")
+
+// ErrNotModified is returned by conditional requests when the server reports
+// that the cached representation identified by the supplied ETag is still current.
+var ErrNotModified = errors.New("property: resource not modified")
+
+// ErrNoResults is returned by CheckStatus, and by services configured with
+// WithNoResultsError, when ATTOM answers successfully but reports that nothing
+// matched the request.
+var ErrNoResults = errors.New("property: no results")
+
+// StatusCodeNoResults is the status block code ATTOM sends with HTTP 200 when a
+// request matched no records. Its message is StatusMsgNoResults.
+const StatusCodeNoResults = 1
+
+// StatusMsgNoResults is the status block message accompanying StatusCodeNoResults.
+const StatusMsgNoResults = "SuccessWithoutResult"
+
+// ErrUnauthorized is wrapped by the *Error returned for HTTP 401 responses,
+// typically caused by a missing, invalid, or expired API key.
+var ErrUnauthorized = errors.New("property: unauthorized")
+
+// ErrForbidden is wrapped by the *Error returned for HTTP 403 responses, when
+// the API key is not entitled to the requested endpoint.
+var ErrForbidden = errors.New("property: forbidden")
+
+// ErrRateLimited is wrapped by the *Error returned for HTTP 429 responses. Use
+// Error.RetryAfter to find how long ATTOM asked the caller to wait.
+var ErrRateLimited = errors.New("property: rate limited")
+
+// Error represents an ATTOM Property API error response. When the status code
+// has a matching sentinel, such as ErrUnauthorized, Err holds it so callers can
+// test for it with errors.Is. Endpoint and Query identify the failed request;
+// credentials in Query are redacted.
 type Error struct {
 	Status     *Status
+	Header     http.Header
+	Query      url.Values
+	Err        error
 	Message    string
+	Endpoint   string
 	Body       json.RawMessage
 	StatusCode int
 }
 
-// Error implements the error interface.
+// Error implements the error interface. When Endpoint is set, the request path
+// and its redacted query are appended, followed by the ATTOM transaction ID from
+// the status block when present so it can be quoted to ATTOM support.
 func (e *Error) Error() string {
 	if e == nil {
 		return "property: nil error"
 	}
+	var details []string
+	if e.Endpoint != "" {
+		request := e.Endpoint
+		if len(e.Query) > 0 {
+			request += "?" + redactQuery(e.Query).Encode()
+		}
+		details = append(details, "request "+request)
+	}
+	if id := e.Status.GetTransactionID(); id != "" {
+		details = append(details, "transaction "+id)
+	}
+	if len(details) == 0 {
+		return e.message()
+	}
+	return fmt.Sprintf("%s (%s)", e.message(), strings.Join(details, ", "))
+}
+
+// message formats the error without request details.
+func (e *Error) message() string {
 	if e.Message != "" {
 		return fmt.Sprintf("property: %s", e.Message)
 	}
@@ -40,3 +108,103 @@ func (e *Error) Error() string {
 	}
 	return fmt.Sprintf("property: http status %d", e.StatusCode)
 }
+
+// redactedQueryValue replaces credentials in query parameters surfaced by Error.
+const redactedQueryValue = "REDACTED"
+
+// redactQuery returns a copy of query with any apikey parameter, matched
+// case-insensitively, replaced by a placeholder. It returns nil for an empty query.
+func redactQuery(query url.Values) url.Values {
+	if len(query) == 0 {
+		return nil
+	}
+	redacted := make(url.Values, len(query))
+	for key, values := range query {
+		if strings.EqualFold(key, "apikey") {
+			redacted[key] = []string{redactedQueryValue}
+			continue
+		}
+		redacted[key] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// Unwrap returns the sentinel error classifying the response, if any.
+func (e *Error) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// CheckStatus returns an error wrapping ErrNoResults when status reports an
+// empty result, either with code StatusCodeNoResults or with the message
+// StatusMsgNoResults (compared case-insensitively). It returns nil for a nil
+// status and for any other status.
+func CheckStatus(status *Status) error {
+	if status == nil {
+		return nil
+	}
+	noResults := status.Code != nil && *status.Code == StatusCodeNoResults
+	if status.Msg != nil && strings.EqualFold(strings.TrimSpace(*status.Msg), StatusMsgNoResults) {
+		noResults = true
+	}
+	if !noResults {
+		return nil
+	}
+	if status.Msg != nil && *status.Msg != "" {
+		return fmt.Errorf("%w: %s", ErrNoResults, *status.Msg)
+	}
+	return ErrNoResults
+}
+
+// statusError returns the sentinel error for an HTTP status code, or nil when
+// the status has no dedicated sentinel.
+func statusError(code int) error {
+	switch code {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
+// RetryAfter returns the delay requested by the response's Retry-After header,
+// which ATTOM sends with 429 rate-limit responses. Both the delay-seconds and
+// HTTP-date forms are supported. The boolean is false when the header is absent
+// or malformed.
+func (e *Error) RetryAfter() (time.Duration, bool) {
+	if e == nil {
+		return 0, false
+	}
+	return client.ParseRetryAfter(e.Header.Get("Retry-After"), time.Now())
+}
+
+// IsRetryable reports whether a request that failed with err may succeed if
+// repeated: rate-limited (429) responses, 5xx responses, wrapped net.Error
+// timeouts, and other transport errors that client.IsRetryable accepts, such as
+// dial failures and dropped connections. Unlike client.IsRetryable, timeouts
+// while reading a response body count as retryable, since the caller repeats the
+// whole request. Deadlines set by the caller's context, other API errors such as
+// 4xx responses, validation errors like ErrMissingParameter, and context
+// cancellation are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	if client.ClassifyTimeout(err) != client.TimeoutNone {
+		return true
+	}
+	return client.IsRetryable(err)
+}
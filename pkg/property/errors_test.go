@@ -0,0 +1,110 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+// netTimeoutError is a net.Error that always reports a timeout.
+type netTimeoutError struct{}
+
+func (netTimeoutError) Error() string   { return "i/o timeout" }
+func (netTimeoutError) Timeout() bool   { return true }
+func (netTimeoutError) Temporary() bool { return true }
+
+// bodyReadTimeout returns the error GetPropertyDetail reports when the server
+// stalls part way through the response body.
+func bodyReadTimeout(t *testing.T) error {
+	t.Helper()
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte(`{"status":`)); err != nil {
+			return
+		}
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	svc := NewService(client.New("test-key", &http.Client{Timeout: 100 * time.Millisecond}, client.WithBaseURL(srv.URL+"/")))
+	_, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+	if err == nil {
+		t.Fatal("expected body read timeout")
+	}
+	return err
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		name string
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "rate limited", err: &Error{StatusCode: http.StatusTooManyRequests, Err: ErrRateLimited}, want: true},
+		{name: "wrapped rate limited", err: fmt.Errorf("lookup: %w", ErrRateLimited), want: true},
+		{name: "internal server error", err: &Error{StatusCode: http.StatusInternalServerError}, want: true},
+		{name: "bad gateway", err: fmt.Errorf("detail: %w", &Error{StatusCode: http.StatusBadGateway}), want: true},
+		{name: "bad request", err: &Error{StatusCode: http.StatusBadRequest}, want: false},
+		{name: "unauthorized", err: &Error{StatusCode: http.StatusUnauthorized, Err: ErrUnauthorized}, want: false},
+		{name: "not found", err: &Error{StatusCode: http.StatusNotFound}, want: false},
+		{name: "missing parameter", err: fmt.Errorf("%w: attomid", ErrMissingParameter), want: false},
+		{name: "net timeout", err: &url.Error{Op: "Get", URL: "https://example.com", Err: netTimeoutError{}}, want: true},
+		{name: "read timeout awaiting headers", err: fmt.Errorf("request: %w", &net.OpError{Op: "read", Net: "tcp", Err: netTimeoutError{}}), want: true},
+		// client.IsRetryable rejects body read timeouts; this predicate accepts them.
+		{name: "body read timeout", err: bodyReadTimeout(t), want: true},
+		{name: "dial failure", err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, want: true},
+		{name: "context canceled", err: fmt.Errorf("request: %w", context.Canceled), want: false},
+		{name: "context deadline", err: context.DeadlineExceeded, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckStatus(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	strPtr := func(v string) *string { return &v }
+	tests := []struct {
+		status *Status
+		name   string
+		want   bool
+	}{
+		{name: "nil", status: nil, want: false},
+		{name: "success with result", status: &Status{Code: intPtr(0), Msg: strPtr("SuccessWithResult")}, want: false},
+		{name: "success without result", status: &Status{Code: intPtr(1), Msg: strPtr("SuccessWithoutResult")}, want: true},
+		{name: "code only", status: &Status{Code: intPtr(StatusCodeNoResults)}, want: true},
+		{name: "message only", status: &Status{Msg: strPtr(" successwithoutresult ")}, want: true},
+		{name: "empty", status: &Status{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckStatus(tt.status)
+			if got := errors.Is(err, ErrNoResults); got != tt.want {
+				t.Errorf("CheckStatus() = %v, want ErrNoResults=%v", err, tt.want)
+			}
+			if !tt.want && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
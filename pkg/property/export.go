@@ -0,0 +1,149 @@
+package property
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// propertyCSVColumn describes one column written by WritePropertiesCSV.
+type propertyCSVColumn struct {
+	value  func(*Property) string
+	header string
+}
+
+// propertyCSVColumns lists the WritePropertiesCSV columns in output order.
+// Nested models are reached through the nil-safe getters, so a missing block
+// yields empty cells rather than zeros.
+var propertyCSVColumns = []propertyCSVColumn{
+	{header: "attom_id", value: func(p *Property) string { return p.GetIdentifier().GetAttomID() }},
+	{header: "fips", value: func(p *Property) string { return p.GetIdentifier().GetFIPS() }},
+	{header: "apn", value: func(p *Property) string { return p.GetIdentifier().GetAPN() }},
+	{header: "address_line1", value: func(p *Property) string { return p.GetAddress().GetLine1() }},
+	{header: "address_line2", value: func(p *Property) string { return p.GetAddress().GetLine2() }},
+	{header: "city", value: func(p *Property) string { return p.GetAddress().GetCity() }},
+	{header: "state", value: func(p *Property) string { return p.GetAddress().GetState() }},
+	{header: "postal_code", value: func(p *Property) string { return p.GetAddress().GetPostalCode() }},
+	{header: "county", value: func(p *Property) string { return p.GetAddress().GetCounty() }},
+	{header: "latitude", value: func(p *Property) string { return csvFloat(p.GetAddress().Latitude) }},
+	{header: "longitude", value: func(p *Property) string { return csvFloat(p.GetAddress().Longitude) }},
+	{header: "property_type", value: func(p *Property) string { return p.GetSummary().GetPropertyType() }},
+	{header: "year_built", value: func(p *Property) string { return csvInt(p.GetSummary().YearBuilt) }},
+	{header: "stories", value: func(p *Property) string { return csvFloat(p.GetSummary().Stories) }},
+	{header: "units_count", value: func(p *Property) string { return csvInt(p.GetSummary().UnitsCount) }},
+	{header: "assessed_total_value", value: func(p *Property) string { return csvFlexFloat(p.GetAssessment().AssessedTotalValue) }},
+	{header: "market_total_value", value: func(p *Property) string { return csvFlexFloat(p.GetAssessment().MarketTotalValue) }},
+	{header: "tax_amount", value: func(p *Property) string { return csvFlexFloat(p.GetAssessment().TaxAmount) }},
+	{header: "tax_year", value: func(p *Property) string { return csvInt(p.GetAssessment().TaxYear) }},
+	{header: "avm_value", value: func(p *Property) string { return csvFloat(p.GetAVM().Value) }},
+	{header: "avm_low", value: func(p *Property) string { return csvFloat(p.GetAVM().Low) }},
+	{header: "avm_high", value: func(p *Property) string { return csvFloat(p.GetAVM().High) }},
+	{header: "avm_score", value: func(p *Property) string { return csvFlexFloat(p.GetAVM().Score) }},
+	{header: "avm_updated", value: func(p *Property) string { return p.GetAVM().GetUpdated() }},
+}
+
+// WritePropertiesCSV writes props to w as CSV: a header row followed by one row
+// per property with its identifier, address, summary, assessment, and AVM
+// fields. The column order is stable. Missing values are written as empty
+// cells, and a nil property produces a row of empty cells.
+func WritePropertiesCSV(w io.Writer, props []*Property) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(propertyCSVColumns))
+	for i, col := range propertyCSVColumns {
+		record[i] = col.header
+	}
+	if err := cw.Write(record); err != nil {
+		return fmt.Errorf("property: failed to write CSV header: %w", err)
+	}
+	for _, p := range props {
+		for i, col := range propertyCSVColumns {
+			record[i] = col.value(p)
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("property: failed to write CSV row: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("property: failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func csvInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func csvFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func csvFlexFloat(v *FlexFloat) string {
+	if v == nil {
+		return ""
+	}
+	return csvFloat((*float64)(v))
+}
+
+// geoJSONFeatureCollection is the GeoJSON document produced by POIResponse.ToGeoJSON.
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// geoJSONFeature is a single GeoJSON feature.
+type geoJSONFeature struct {
+	Geometry   *Geometry              `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+	Type       string                 `json:"type"`
+}
+
+// ToGeoJSON encodes the POIs as a GeoJSON FeatureCollection suitable for map
+// libraries such as Leaflet. Each POI with both coordinates in its GeoLocation
+// becomes a Point feature whose properties hold its id, name, category, and
+// distance when present; POIs missing coordinates are skipped. A nil response
+// yields an empty collection.
+func (p *POIResponse) ToGeoJSON() ([]byte, error) {
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	for _, poi := range p.GetPOIs() {
+		location := poi.GetGeoLocation()
+		if location.Latitude == nil || location.Longitude == nil {
+			continue
+		}
+		pointType := GeometryTypePoint
+		properties := make(map[string]interface{})
+		if poi.ID != nil {
+			properties["id"] = *poi.ID
+		}
+		if poi.Name != nil {
+			properties["name"] = *poi.Name
+		}
+		if poi.Category != nil {
+			properties["category"] = *poi.Category
+		}
+		if poi.Distance != nil {
+			properties["distance"] = *poi.Distance
+		}
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: &Geometry{
+				Type:        &pointType,
+				Coordinates: []float64{*location.Longitude, *location.Latitude},
+			},
+			Properties: properties,
+		})
+	}
+	data, err := json.Marshal(collection)
+	if err != nil {
+		return nil, fmt.Errorf("property: failed to encode GeoJSON: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,126 @@
+package property
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+)
+
+func TestWritePropertiesCSV(t *testing.T) {
+	var resp DetailResponse
+	body := `{"property":[
+		{"identifier":{"attomId":"100","fips":"08031","apn":"123-45"},
+		 "address":{"line1":"123 Main St","line2":"Denver, CO 80202","city":"Denver","state":"CO","postalCode":"80202","latitude":39.74,"longitude":-104.99},
+		 "summary":{"propertyType":"SFR","yearBuilt":1998},
+		 "assessment":{"assdTtlValue":"350000","taxAmt":2100.5,"taxYear":2024},
+		 "avm":{"value":512000,"low":490000,"high":530000,"score":"87"}},
+		{"identifier":{"attomId":"200"}}
+	]}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	props := append(resp.Property, nil)
+
+	var buf bytes.Buffer
+	if err := WritePropertiesCSV(&buf, props); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("parse CSV: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected header and 3 rows, got %d records", len(records))
+	}
+
+	header := records[0]
+	if header[0] != "attom_id" || len(header) != len(propertyCSVColumns) {
+		t.Fatalf("unexpected header %v", header)
+	}
+	row := make(map[string]string, len(header))
+	for i, name := range header {
+		row[name] = records[1][i]
+	}
+	want := map[string]string{
+		"attom_id":             "100",
+		"apn":                  "123-45",
+		"address_line1":        "123 Main St",
+		"address_line2":        "Denver, CO 80202",
+		"postal_code":          "80202",
+		"latitude":             "39.74",
+		"longitude":            "-104.99",
+		"property_type":        "SFR",
+		"year_built":           "1998",
+		"stories":              "",
+		"assessed_total_value": "350000",
+		"tax_amount":           "2100.5",
+		"tax_year":             "2024",
+		"avm_value":            "512000",
+		"avm_score":            "87",
+	}
+	for name, value := range want {
+		if row[name] != value {
+			t.Errorf("column %s = %q, want %q", name, row[name], value)
+		}
+	}
+
+	if records[2][0] != "200" || records[2][len(header)-1] != "" {
+		t.Errorf("unexpected sparse row %v", records[2])
+	}
+	for i, cell := range records[3] {
+		if cell != "" {
+			t.Errorf("expected empty cells for nil property, column %s = %q", header[i], cell)
+		}
+	}
+}
+
+func TestPOIResponseToGeoJSON(t *testing.T) {
+	var resp POIResponse
+	body := `{"poi":[
+		{"id":"1","name":"Union Station","category":"TRANSPORTATION","geoLocation":{"lat":39.7527,"lon":-105.0001},"distance":0.4},
+		{"id":"2","name":"No Coordinates","geoLocation":{"lat":39.7}},
+		{"name":"Coffee","category":"EATING - DRINKING","geoLocation":{"lat":39.75,"lon":-104.99}}
+	]}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	data, err := resp.ToGeoJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry   Geometry               `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+			Type       string                 `json:"type"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		t.Fatalf("decode GeoJSON: %v", err)
+	}
+	if collection.Type != "FeatureCollection" || len(collection.Features) != 2 {
+		t.Fatalf("expected FeatureCollection with 2 features, got %s with %d", collection.Type, len(collection.Features))
+	}
+	first := collection.Features[0]
+	if first.Type != "Feature" || first.Properties["name"] != "Union Station" || first.Properties["category"] != "TRANSPORTATION" {
+		t.Errorf("unexpected first feature %+v", first)
+	}
+	wkt, err := first.Geometry.ToWKT()
+	if err != nil || wkt != "POINT(-105.0001 39.7527)" {
+		t.Errorf("expected [lon, lat] point, got %q (err %v)", wkt, err)
+	}
+	if _, ok := collection.Features[1].Properties["id"]; ok {
+		t.Errorf("expected missing id to be omitted, got %v", collection.Features[1].Properties)
+	}
+
+	t.Run("nil response", func(t *testing.T) {
+		var nilResp *POIResponse
+		data, err := nilResp.ToGeoJSON()
+		if err != nil || string(data) != `{"type":"FeatureCollection","features":[]}` {
+			t.Errorf("unexpected output %s (err %v)", data, err)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+//go:build ignore
+
+// gen-accessors generates nil-safe Get accessors for the pointer fields of the
+// structs declared in models.go. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	source = "models.go"
+	output = "models_accessors.go"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && name != output && name != "gen-accessors.go"
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["property"]
+	if !ok {
+		log.Fatal("package property not found")
+	}
+
+	structs := map[string]bool{}
+	methods := map[string]bool{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && !ts.Assign.IsValid() {
+						if _, isStruct := ts.Type.(*ast.StructType); isStruct {
+							structs[ts.Name.Name] = true
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil && len(d.Recv.List) == 1 {
+					methods[receiverName(d.Recv.List[0].Type)+"."+d.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	var models *ast.File
+	for name, file := range pkg.Files {
+		if strings.HasSuffix(name, source) {
+			models = file
+		}
+	}
+	if models == nil {
+		log.Fatalf("%s not found", source)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen-accessors.go; DO NOT EDIT.\n\npackage property\n\n")
+	uses := map[string]bool{}
+	for _, decl := range models.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || ts.Assign.IsValid() {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				for _, ident := range field.Names {
+					if !ident.IsExported() {
+						continue
+					}
+					method := "Get" + ident.Name
+					if methods[ts.Name.Name+"."+method] {
+						continue
+					}
+					writeAccessor(&buf, fset, ts.Name.Name, ident.Name, method, field.Type, structs, uses)
+				}
+			}
+		}
+	}
+
+	src := buf.Bytes()
+	if uses["json"] {
+		src = bytes.Replace(src, []byte("package property\n"), []byte("package property\n\nimport \"encoding/json\"\n"), 1)
+	}
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("format generated source: %v\n%s", err, src)
+	}
+	if err := os.WriteFile(output, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// zeroLiterals maps field types to the literal returned for a nil field.
+var zeroLiterals = map[string]string{
+	"string":    `""`,
+	"int":       "0",
+	"int64":     "0",
+	"float64":   "0",
+	"bool":      "false",
+	"FlexFloat": "0",
+	"FlexInt":   "0",
+	"FlexBool":  "false",
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func writeAccessor(buf *bytes.Buffer, fset *token.FileSet, typeName, fieldName, method string, expr ast.Expr, structs, uses map[string]bool) {
+	recv := strings.ToLower(typeName[:1])
+	if star, ok := expr.(*ast.StarExpr); ok {
+		elem := exprString(fset, star.X)
+		if ident, ok := star.X.(*ast.Ident); ok && structs[ident.Name] {
+			fmt.Fprintf(buf, "// %s returns the %s field, or an empty %s if %s or the field is nil.\n", method, fieldName, elem, typeName)
+			fmt.Fprintf(buf, "func (%s *%s) %s() *%s {\n\tif %s == nil || %s.%s == nil {\n\t\treturn &%s{}\n\t}\n\treturn %s.%s\n}\n\n",
+				recv, typeName, method, elem, recv, recv, fieldName, elem, recv, fieldName)
+			return
+		}
+		fmt.Fprintf(buf, "// %s returns the %s field, or the zero value if %s or the field is nil.\n", method, fieldName, typeName)
+		if zero, ok := zeroLiterals[elem]; ok {
+			fmt.Fprintf(buf, "func (%s *%s) %s() %s {\n\tif %s == nil || %s.%s == nil {\n\t\treturn %s\n\t}\n\treturn *%s.%s\n}\n\n",
+				recv, typeName, method, elem, recv, recv, fieldName, zero, recv, fieldName)
+			return
+		}
+		fmt.Fprintf(buf, "func (%s *%s) %s() %s {\n\tif %s == nil || %s.%s == nil {\n\t\tvar zero %s\n\t\treturn zero\n\t}\n\treturn *%s.%s\n}\n\n",
+			recv, typeName, method, elem, recv, recv, fieldName, elem, recv, fieldName)
+		return
+	}
+	typ := exprString(fset, expr)
+	if strings.Contains(typ, "json.") {
+		uses["json"] = true
+	}
+	fmt.Fprintf(buf, "// %s returns the %s field, or the zero value if %s is nil.\n", method, fieldName, typeName)
+	if zero, ok := zeroLiterals[typ]; ok {
+		fmt.Fprintf(buf, "func (%s *%s) %s() %s {\n\tif %s == nil {\n\t\treturn %s\n\t}\n\treturn %s.%s\n}\n\n",
+			recv, typeName, method, typ, recv, zero, recv, fieldName)
+		return
+	}
+	if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") || typ == "interface{}" || typ == "any" || typ == "json.RawMessage" {
+		fmt.Fprintf(buf, "func (%s *%s) %s() %s {\n\tif %s == nil {\n\t\treturn nil\n\t}\n\treturn %s.%s\n}\n\n",
+			recv, typeName, method, typ, recv, recv, fieldName)
+		return
+	}
+	fmt.Fprintf(buf, "func (%s *%s) %s() %s {\n\tif %s == nil {\n\t\tvar zero %s\n\t\treturn zero\n\t}\n\treturn %s.%s\n}\n\n",
+		recv, typeName, method, typ, recv, typ, recv, fieldName)
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
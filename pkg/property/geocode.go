@@ -0,0 +1,55 @@
+package property
+
+import "strings"
+
+// ParseMatchQuality maps a raw ATTOM match code or accuracy value to a MatchQuality.
+// Matching is case-insensitive; unrecognized or empty values yield MatchQualityUnknown.
+func ParseMatchQuality(raw string) MatchQuality {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "rooftop", "parcel", "exact":
+		return MatchQualityRooftop
+	case "street", "exastr", "interpolated", "interpolation":
+		return MatchQualityStreet
+	case "zip9", "zip+4", "zip4":
+		return MatchQualityZip9
+	case "zip7":
+		return MatchQualityZip7
+	case "zip5", "zip", "postal":
+		return MatchQualityZip5
+	default:
+		return MatchQualityUnknown
+	}
+}
+
+// MatchQuality returns the geocode precision, preferring Quality over MatchCode.
+func (g *GeoLocation) MatchQuality() MatchQuality {
+	if g == nil {
+		return MatchQualityUnknown
+	}
+	return matchQualityOf(g.Quality, g.MatchCode)
+}
+
+// IsRooftopMatch reports whether the location was geocoded to rooftop precision.
+func (g *GeoLocation) IsRooftopMatch() bool {
+	return g.MatchQuality() == MatchQualityRooftop
+}
+
+// MatchQuality returns the geocode precision, preferring Quality over MatchCode.
+func (c *SaleComparable) MatchQuality() MatchQuality {
+	if c == nil {
+		return MatchQualityUnknown
+	}
+	return matchQualityOf(c.Quality, c.MatchCode)
+}
+
+func matchQualityOf(quality, matchCode *string) MatchQuality {
+	if quality != nil {
+		if q := ParseMatchQuality(*quality); q != MatchQualityUnknown {
+			return q
+		}
+	}
+	if matchCode != nil {
+		return ParseMatchQuality(*matchCode)
+	}
+	return MatchQualityUnknown
+}
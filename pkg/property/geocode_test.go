@@ -0,0 +1,71 @@
+package property
+
+import "testing"
+
+func TestParseMatchQuality(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want MatchQuality
+	}{
+		{raw: "Rooftop", want: MatchQualityRooftop},
+		{raw: "ROOFTOP", want: MatchQualityRooftop},
+		{raw: "parcel", want: MatchQualityRooftop},
+		{raw: "ExaStr", want: MatchQualityStreet},
+		{raw: "Street", want: MatchQualityStreet},
+		{raw: "interpolated", want: MatchQualityStreet},
+		{raw: "Zip9", want: MatchQualityZip9},
+		{raw: "ZIP+4", want: MatchQualityZip9},
+		{raw: "Zip7", want: MatchQualityZip7},
+		{raw: "Zip5", want: MatchQualityZip5},
+		{raw: " zip ", want: MatchQualityZip5},
+		{raw: "", want: MatchQualityUnknown},
+		{raw: "somewhere", want: MatchQualityUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := ParseMatchQuality(tt.raw); got != tt.want {
+				t.Errorf("ParseMatchQuality(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeoLocationMatchQuality(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	t.Run("nil location", func(t *testing.T) {
+		var g *GeoLocation
+		if g.MatchQuality() != MatchQualityUnknown || g.IsRooftopMatch() {
+			t.Errorf("expected unknown, non-rooftop for nil location")
+		}
+	})
+
+	t.Run("quality preferred", func(t *testing.T) {
+		g := &GeoLocation{Quality: str("Rooftop"), MatchCode: str("Zip5")}
+		if !g.IsRooftopMatch() {
+			t.Errorf("expected rooftop match, got %q", g.MatchQuality())
+		}
+	})
+
+	t.Run("falls back to match code", func(t *testing.T) {
+		g := &GeoLocation{Quality: str("n/a"), MatchCode: str("ExaStr")}
+		if got := g.MatchQuality(); got != MatchQualityStreet {
+			t.Errorf("expected street, got %q", got)
+		}
+		if g.IsRooftopMatch() {
+			t.Errorf("expected non-rooftop match")
+		}
+	})
+
+	t.Run("sale comparable", func(t *testing.T) {
+		c := &SaleComparable{MatchCode: str("Zip9")}
+		if got := c.MatchQuality(); got != MatchQualityZip9 {
+			t.Errorf("expected zip9, got %q", got)
+		}
+		var nilComp *SaleComparable
+		if nilComp.MatchQuality() != MatchQualityUnknown {
+			t.Errorf("expected unknown for nil comparable")
+		}
+	})
+}
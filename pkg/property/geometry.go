@@ -0,0 +1,105 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Geometry types supported by Geometry.ToWKT.
+const (
+	GeometryTypePoint        = "Point"
+	GeometryTypePolygon      = "Polygon"
+	GeometryTypeMultiPolygon = "MultiPolygon"
+)
+
+// ToWKT converts the GeoJSON geometry to well-known text, for example for
+// ingestion into PostGIS. Point, Polygon, and MultiPolygon geometries are
+// supported; rings are emitted in their GeoJSON order, exterior ring first, and
+// closed when the last position does not repeat the first. GeoJSON positions are
+// already in WKT's longitude-latitude order. Any elevation is dropped.
+func (g *Geometry) ToWKT() (string, error) {
+	if g == nil || g.Type == nil {
+		return "", fmt.Errorf("property: geometry type is missing")
+	}
+	raw, err := json.Marshal(g.Coordinates)
+	if err != nil {
+		return "", fmt.Errorf("property: invalid %s coordinates: %w", *g.Type, err)
+	}
+	switch *g.Type {
+	case GeometryTypePoint:
+		var position []float64
+		if err := json.Unmarshal(raw, &position); err != nil {
+			return "", fmt.Errorf("property: invalid Point coordinates: %w", err)
+		}
+		pos, err := wktPosition(position)
+		if err != nil {
+			return "", err
+		}
+		return "POINT(" + pos + ")", nil
+	case GeometryTypePolygon:
+		var rings [][][]float64
+		if err := json.Unmarshal(raw, &rings); err != nil {
+			return "", fmt.Errorf("property: invalid Polygon coordinates: %w", err)
+		}
+		body, err := wktPolygonBody(rings)
+		if err != nil {
+			return "", err
+		}
+		return "POLYGON" + body, nil
+	case GeometryTypeMultiPolygon:
+		var polygons [][][][]float64
+		if err := json.Unmarshal(raw, &polygons); err != nil {
+			return "", fmt.Errorf("property: invalid MultiPolygon coordinates: %w", err)
+		}
+		if len(polygons) == 0 {
+			return "", fmt.Errorf("property: MultiPolygon has no polygons")
+		}
+		bodies := make([]string, 0, len(polygons))
+		for _, rings := range polygons {
+			body, err := wktPolygonBody(rings)
+			if err != nil {
+				return "", err
+			}
+			bodies = append(bodies, body)
+		}
+		return "MULTIPOLYGON(" + strings.Join(bodies, ", ") + ")", nil
+	default:
+		return "", fmt.Errorf("property: unsupported geometry type %q", *g.Type)
+	}
+}
+
+// wktPolygonBody formats rings as "((x y, ...), (x y, ...))", closing each ring.
+func wktPolygonBody(rings [][][]float64) (string, error) {
+	if len(rings) == 0 {
+		return "", fmt.Errorf("property: polygon has no rings")
+	}
+	formatted := make([]string, 0, len(rings))
+	for _, ring := range rings {
+		if len(ring) < 3 {
+			return "", fmt.Errorf("property: polygon ring needs at least 3 positions, got %d", len(ring))
+		}
+		positions := make([]string, 0, len(ring)+1)
+		for _, position := range ring {
+			pos, err := wktPosition(position)
+			if err != nil {
+				return "", err
+			}
+			positions = append(positions, pos)
+		}
+		if positions[0] != positions[len(positions)-1] {
+			positions = append(positions, positions[0])
+		}
+		formatted = append(formatted, "("+strings.Join(positions, ", ")+")")
+	}
+	return "(" + strings.Join(formatted, ", ") + ")", nil
+}
+
+// wktPosition formats a GeoJSON [longitude, latitude, ...] position.
+func wktPosition(position []float64) (string, error) {
+	if len(position) < 2 {
+		return "", fmt.Errorf("property: position needs longitude and latitude, got %v", position)
+	}
+	return strconv.FormatFloat(position[0], 'f', -1, 64) + " " + strconv.FormatFloat(position[1], 'f', -1, 64), nil
+}
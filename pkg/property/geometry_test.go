@@ -0,0 +1,84 @@
+package property
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGeometryToWKT(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry string
+		want     string
+	}{
+		{
+			name:     "point",
+			geometry: `{"type":"Point","coordinates":[-104.9903,39.7392]}`,
+			want:     "POINT(-104.9903 39.7392)",
+		},
+		{
+			name:     "triangle polygon",
+			geometry: `{"type":"Polygon","coordinates":[[[-105,39],[-104,39],[-104.5,40],[-105,39]]]}`,
+			want:     "POLYGON((-105 39, -104 39, -104.5 40, -105 39))",
+		},
+		{
+			name:     "unclosed ring is closed",
+			geometry: `{"type":"Polygon","coordinates":[[[-105,39],[-104,39],[-104.5,40]]]}`,
+			want:     "POLYGON((-105 39, -104 39, -104.5 40, -105 39))",
+		},
+		{
+			name:     "polygon with hole",
+			geometry: `{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,10],[0,0]],[[2,2],[2,4],[4,4],[4,2],[2,2]]]}`,
+			want:     "POLYGON((0 0, 10 0, 10 10, 0 10, 0 0), (2 2, 2 4, 4 4, 4 2, 2 2))",
+		},
+		{
+			name:     "two-ring multipolygon",
+			geometry: `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[5,5],[6,5],[6,6],[5,6],[5,5]]]]}`,
+			want:     "MULTIPOLYGON(((0 0, 1 0, 1 1, 0 0)), ((5 5, 6 5, 6 6, 5 6, 5 5)))",
+		},
+		{
+			name:     "elevation dropped",
+			geometry: `{"type":"Point","coordinates":[-104.99,39.74,1609]}`,
+			want:     "POINT(-104.99 39.74)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g Geometry
+			if err := json.Unmarshal([]byte(tt.geometry), &g); err != nil {
+				t.Fatalf("decode geometry: %v", err)
+			}
+			got, err := g.ToWKT()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ToWKT() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometryToWKTErrors(t *testing.T) {
+	lineString := "LineString"
+	polygon := GeometryTypePolygon
+	tests := []struct {
+		name     string
+		geometry *Geometry
+		want     string
+	}{
+		{name: "nil", geometry: nil, want: "type is missing"},
+		{name: "unsupported type", geometry: &Geometry{Type: &lineString, Coordinates: []any{[]any{0.0, 0.0}}}, want: "unsupported geometry type"},
+		{name: "short ring", geometry: &Geometry{Type: &polygon, Coordinates: [][][]float64{{{0, 0}, {1, 1}}}}, want: "at least 3 positions"},
+		{name: "malformed coordinates", geometry: &Geometry{Type: &polygon, Coordinates: "abc"}, want: "invalid Polygon coordinates"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.geometry.ToWKT()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
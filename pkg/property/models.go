@@ -1,152 +1,156 @@
 package property
 
+//go:generate go run gen-accessors.go
+
 import "encoding/json"
 
 // Status describes the standard ATTOM response status block.
 type Status struct {
-	Version  *string `json:"version,omitempty"`
-	Code     *int    `json:"code,omitempty"`
-	Msg      *string `json:"msg,omitempty"`
-	Total    *int    `json:"total,omitempty"`
-	Page     *int    `json:"page,omitempty"`
-	PageSize *int    `json:"pagesize,omitempty"`
+	Version       *string `json:"version,omitempty" xml:"version,omitempty"`
+	Code          *int    `json:"code,omitempty" xml:"code,omitempty"`
+	Msg           *string `json:"msg,omitempty" xml:"msg,omitempty"`
+	Total         *int    `json:"total,omitempty" xml:"total,omitempty"`
+	Page          *int    `json:"page,omitempty" xml:"page,omitempty"`
+	PageSize      *int    `json:"pagesize,omitempty" xml:"pagesize,omitempty"`
+	TransactionID *string `json:"transactionId,omitempty" xml:"transactionId,omitempty"`
 }
 
 // Identifier contains core identifiers for a property record.
 type Identifier struct {
-	AttomID  *string `json:"attomId,omitempty"`
-	ID       *string `json:"id,omitempty"`
-	FIPS     *string `json:"fips,omitempty"`
-	APN      *string `json:"apn,omitempty"`
-	ObPropID *string `json:"obPropId,omitempty"`
+	AttomID  *string `json:"attomId,omitempty" xml:"attomId,omitempty"`
+	ID       *string `json:"id,omitempty" xml:"id,omitempty"`
+	FIPS     *string `json:"fips,omitempty" xml:"fips,omitempty"`
+	APN      *string `json:"apn,omitempty" xml:"apn,omitempty"`
+	ObPropID *string `json:"obPropId,omitempty" xml:"obPropId,omitempty"`
 }
 
 // Address represents a postal address and geographic coordinates.
 type Address struct {
-	Line1      *string  `json:"line1,omitempty"`
-	Line2      *string  `json:"line2,omitempty"`
-	City       *string  `json:"city,omitempty"`
-	State      *string  `json:"state,omitempty"`
-	County     *string  `json:"county,omitempty"`
-	Country    *string  `json:"country,omitempty"`
-	PostalCode *string  `json:"postalCode,omitempty"`
-	UnitNumber *string  `json:"unitNumber,omitempty"`
-	Latitude   *float64 `json:"latitude,omitempty"`
-	Longitude  *float64 `json:"longitude,omitempty"`
+	Line1      *string  `json:"line1,omitempty" xml:"line1,omitempty"`
+	Line2      *string  `json:"line2,omitempty" xml:"line2,omitempty"`
+	City       *string  `json:"city,omitempty" xml:"city,omitempty"`
+	State      *string  `json:"state,omitempty" xml:"state,omitempty"`
+	County     *string  `json:"county,omitempty" xml:"county,omitempty"`
+	Country    *string  `json:"country,omitempty" xml:"country,omitempty"`
+	PostalCode *string  `json:"postalCode,omitempty" xml:"postalCode,omitempty"`
+	UnitNumber *string  `json:"unitNumber,omitempty" xml:"unitNumber,omitempty"`
+	Latitude   *float64 `json:"latitude,omitempty" xml:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty" xml:"longitude,omitempty"`
 }
 
 // GeoLocation captures latitude and longitude alongside precision metadata.
 type GeoLocation struct {
-	Latitude  *float64 `json:"lat,omitempty"`
-	Longitude *float64 `json:"lon,omitempty"`
-	MatchCode *string  `json:"matchCode,omitempty"`
-	Quality   *string  `json:"quality,omitempty"`
+	Latitude  *float64 `json:"lat,omitempty" xml:"lat,omitempty"`
+	Longitude *float64 `json:"lon,omitempty" xml:"lon,omitempty"`
+	MatchCode *string  `json:"matchCode,omitempty" xml:"matchCode,omitempty"`
+	Quality   *string  `json:"quality,omitempty" xml:"quality,omitempty"`
 }
 
 // Lot describes lot-specific attributes for a property.
 type Lot struct {
-	Acres          *float64 `json:"acres,omitempty"`
-	Depth          *float64 `json:"depth,omitempty"`
-	Frontage       *float64 `json:"frontage,omitempty"`
-	AreaSquareFeet *float64 `json:"areaSqFt,omitempty"`
-	LotNumber      *string  `json:"lotNumber,omitempty"`
-	Range          *string  `json:"range,omitempty"`
-	Section        *string  `json:"section,omitempty"`
-	Township       *string  `json:"township,omitempty"`
-	Shape          *string  `json:"shape,omitempty"`
-	Zoning         *string  `json:"zoning,omitempty"`
-	Pool           *string  `json:"pool,omitempty"`
+	Acres          *float64 `json:"acres,omitempty" xml:"acres,omitempty"`
+	Depth          *float64 `json:"depth,omitempty" xml:"depth,omitempty"`
+	Frontage       *float64 `json:"frontage,omitempty" xml:"frontage,omitempty"`
+	AreaSquareFeet *float64 `json:"areaSqFt,omitempty" xml:"areaSqFt,omitempty"`
+	LotNumber      *string  `json:"lotNumber,omitempty" xml:"lotNumber,omitempty"`
+	Range          *string  `json:"range,omitempty" xml:"range,omitempty"`
+	Section        *string  `json:"section,omitempty" xml:"section,omitempty"`
+	Township       *string  `json:"township,omitempty" xml:"township,omitempty"`
+	Shape          *string  `json:"shape,omitempty" xml:"shape,omitempty"`
+	Zoning         *string  `json:"zoning,omitempty" xml:"zoning,omitempty"`
+	Pool           *string  `json:"pool,omitempty" xml:"pool,omitempty"`
 }
 
 // Summary provides high-level information about a property.
 type Summary struct {
-	PropertyType            *string  `json:"propertyType,omitempty"`
-	PropertyTypeDescription *string  `json:"propertyTypeDescription,omitempty"`
-	YearBuilt               *int     `json:"yearBuilt,omitempty"`
-	EffectiveYearBuilt      *int     `json:"effectiveYearBuilt,omitempty"`
-	Stories                 *float64 `json:"stories,omitempty"`
-	UnitsCount              *int     `json:"unitsCount,omitempty"`
-	LegalDescription        *string  `json:"legalDescription,omitempty"`
-	PropertyIndicator       *int     `json:"propertyIndicator,omitempty"`
+	PropertyType            *string  `json:"propertyType,omitempty" xml:"propertyType,omitempty"`
+	PropertyTypeDescription *string  `json:"propertyTypeDescription,omitempty" xml:"propertyTypeDescription,omitempty"`
+	YearBuilt               *int     `json:"yearBuilt,omitempty" xml:"yearBuilt,omitempty"`
+	EffectiveYearBuilt      *int     `json:"effectiveYearBuilt,omitempty" xml:"effectiveYearBuilt,omitempty"`
+	Stories                 *float64 `json:"stories,omitempty" xml:"stories,omitempty"`
+	UnitsCount              *int     `json:"unitsCount,omitempty" xml:"unitsCount,omitempty"`
+	LegalDescription        *string  `json:"legalDescription,omitempty" xml:"legalDescription,omitempty"`
+	PropertyIndicator       *int     `json:"propertyIndicator,omitempty" xml:"propertyIndicator,omitempty"`
 }
 
 // Building describes structure-level detail.
 type Building struct {
-	Construction *Construction    `json:"construction,omitempty"`
-	Rooms        *Rooms           `json:"rooms,omitempty"`
-	Area         *BuildingArea    `json:"area,omitempty"`
-	Interior     *Interior        `json:"interior,omitempty"`
-	Exterior     *Exterior        `json:"exterior,omitempty"`
-	Summary      *BuildingSummary `json:"summary,omitempty"`
+	Construction *Construction    `json:"construction,omitempty" xml:"construction,omitempty"`
+	Rooms        *Rooms           `json:"rooms,omitempty" xml:"rooms,omitempty"`
+	Area         *BuildingArea    `json:"area,omitempty" xml:"area,omitempty"`
+	Interior     *Interior        `json:"interior,omitempty" xml:"interior,omitempty"`
+	Exterior     *Exterior        `json:"exterior,omitempty" xml:"exterior,omitempty"`
+	Summary      *BuildingSummary `json:"summary,omitempty" xml:"summary,omitempty"`
 }
 
 // Construction captures construction-specific information.
 type Construction struct {
-	FrameType        *string `json:"frameType,omitempty"`
-	Foundation       *string `json:"foundation,omitempty"`
-	RoofCover        *string `json:"roofCover,omitempty"`
-	RoofType         *string `json:"roofType,omitempty"`
-	WallType         *string `json:"wallType,omitempty"`
-	FloorType        *string `json:"floorType,omitempty"`
-	CoolingType      *string `json:"coolingType,omitempty"`
-	HeatingType      *string `json:"heatingType,omitempty"`
-	ConstructionType *string `json:"constructionType,omitempty"`
+	FrameType        *string `json:"frameType,omitempty" xml:"frameType,omitempty"`
+	Foundation       *string `json:"foundation,omitempty" xml:"foundation,omitempty"`
+	RoofCover        *string `json:"roofCover,omitempty" xml:"roofCover,omitempty"`
+	RoofType         *string `json:"roofType,omitempty" xml:"roofType,omitempty"`
+	WallType         *string `json:"wallType,omitempty" xml:"wallType,omitempty"`
+	FloorType        *string `json:"floorType,omitempty" xml:"floorType,omitempty"`
+	CoolingType      *string `json:"coolingType,omitempty" xml:"coolingType,omitempty"`
+	HeatingType      *string `json:"heatingType,omitempty" xml:"heatingType,omitempty"`
+	ConstructionType *string `json:"constructionType,omitempty" xml:"constructionType,omitempty"`
 }
 
 // Rooms captures bedroom and bathroom counts.
 type Rooms struct {
-	TotalRooms        *int     `json:"totalRooms,omitempty"`
-	Beds              *int     `json:"beds,omitempty"`
-	BathsFull         *int     `json:"bathsFull,omitempty"`
-	BathsHalf         *int     `json:"bathsHalf,omitempty"`
-	BathsThreeQuarter *int     `json:"bathsThreeQuarter,omitempty"`
-	BathsTotal        *float64 `json:"bathsTotal,omitempty"`
+	TotalRooms        *int       `json:"totalRooms,omitempty" xml:"totalRooms,omitempty"`
+	Beds              *int       `json:"beds,omitempty" xml:"beds,omitempty"`
+	BathsFull         *FlexFloat `json:"bathsFull,omitempty" xml:"bathsFull,omitempty"`
+	BathsHalf         *FlexFloat `json:"bathsHalf,omitempty" xml:"bathsHalf,omitempty"`
+	BathsThreeQuarter *FlexFloat `json:"bathsThreeQuarter,omitempty" xml:"bathsThreeQuarter,omitempty"`
+	BathsTotal        *FlexFloat `json:"bathsTotal,omitempty" xml:"bathsTotal,omitempty"`
+	BathsCalculated   *FlexFloat `json:"bathsCalculated,omitempty" xml:"bathsCalculated,omitempty"`
 }
 
 // BuildingArea stores various square footage measurements.
 type BuildingArea struct {
-	LivingSquareFeet   *int `json:"livingSqFt,omitempty"`
-	TotalSquareFeet    *int `json:"totalSqFt,omitempty"`
-	GarageSquareFeet   *int `json:"garageSqFt,omitempty"`
-	BasementSquareFeet *int `json:"basementSqFt,omitempty"`
-	AtticSquareFeet    *int `json:"atticSqFt,omitempty"`
+	LivingSquareFeet   *FlexInt `json:"livingSqFt,omitempty" xml:"livingSqFt,omitempty"`
+	TotalSquareFeet    *FlexInt `json:"totalSqFt,omitempty" xml:"totalSqFt,omitempty"`
+	GarageSquareFeet   *FlexInt `json:"garageSqFt,omitempty" xml:"garageSqFt,omitempty"`
+	BasementSquareFeet *FlexInt `json:"basementSqFt,omitempty" xml:"basementSqFt,omitempty"`
+	AtticSquareFeet    *FlexInt `json:"atticSqFt,omitempty" xml:"atticSqFt,omitempty"`
 }
 
 // Interior captures interior attributes such as fireplaces.
 type Interior struct {
-	FireplaceCount *int    `json:"fireplaceCount,omitempty"`
-	FlooringType   *string `json:"flooringType,omitempty"`
-	Laundry        *string `json:"laundry,omitempty"`
+	FireplaceCount *int    `json:"fireplaceCount,omitempty" xml:"fireplaceCount,omitempty"`
+	FlooringType   *string `json:"flooringType,omitempty" xml:"flooringType,omitempty"`
+	Laundry        *string `json:"laundry,omitempty" xml:"laundry,omitempty"`
 }
 
 // Exterior holds exterior feature information.
 type Exterior struct {
-	GarageType    *string `json:"garageType,omitempty"`
-	ParkingSpaces *int    `json:"parkingSpaces,omitempty"`
-	PorchType     *string `json:"porchType,omitempty"`
-	PatioType     *string `json:"patioType,omitempty"`
+	GarageType    *string `json:"garageType,omitempty" xml:"garageType,omitempty"`
+	ParkingSpaces *int    `json:"parkingSpaces,omitempty" xml:"parkingSpaces,omitempty"`
+	PorchType     *string `json:"porchType,omitempty" xml:"porchType,omitempty"`
+	PatioType     *string `json:"patioType,omitempty" xml:"patioType,omitempty"`
 }
 
 // BuildingSummary collates additional building-level metrics.
 type BuildingSummary struct {
-	Quality            *string `json:"quality,omitempty"`
-	Condition          *string `json:"condition,omitempty"`
-	ArchitecturalStyle *string `json:"style,omitempty"`
-	PropClass          *string `json:"propClass,omitempty"`
+	Quality            *string `json:"quality,omitempty" xml:"quality,omitempty"`
+	Condition          *string `json:"condition,omitempty" xml:"condition,omitempty"`
+	ArchitecturalStyle *string `json:"style,omitempty" xml:"style,omitempty"`
+	PropClass          *string `json:"propClass,omitempty" xml:"propClass,omitempty"`
 }
 
 // Assessment represents property tax assessment information.
 type Assessment struct {
-	AssessedTotalValue       *float64 `json:"assdTtlValue,omitempty"`
-	AssessedLandValue        *float64 `json:"assdLandValue,omitempty"`
-	AssessedImprovementValue *float64 `json:"assdImpValue,omitempty"`
-	MarketTotalValue         *float64 `json:"mktTtlValue,omitempty"`
-	MarketLandValue          *float64 `json:"mktLandValue,omitempty"`
-	MarketImprovementValue   *float64 `json:"mktImpValue,omitempty"`
-	TaxAmount                *float64 `json:"taxAmt,omitempty"`
-	TaxYear                  *int     `json:"taxYear,omitempty"`
-	TaxRate                  *float64 `json:"taxRate,omitempty"`
-	AppraisedValue           *float64 `json:"apprsdTotValue,omitempty"`
+	AssessedTotalValue       *FlexFloat `json:"assdTtlValue,omitempty" xml:"assdTtlValue,omitempty"`
+	AssessedLandValue        *FlexFloat `json:"assdLandValue,omitempty" xml:"assdLandValue,omitempty"`
+	AssessedImprovementValue *FlexFloat `json:"assdImpValue,omitempty" xml:"assdImpValue,omitempty"`
+	MarketTotalValue         *FlexFloat `json:"mktTtlValue,omitempty" xml:"mktTtlValue,omitempty"`
+	MarketLandValue          *FlexFloat `json:"mktLandValue,omitempty" xml:"mktLandValue,omitempty"`
+	MarketImprovementValue   *FlexFloat `json:"mktImpValue,omitempty" xml:"mktImpValue,omitempty"`
+	TaxAmount                *FlexFloat `json:"taxAmt,omitempty" xml:"taxAmt,omitempty"`
+	TaxYear                  *int       `json:"taxYear,omitempty" xml:"taxYear,omitempty"`
+	TaxRate                  *FlexFloat `json:"taxRate,omitempty" xml:"taxRate,omitempty"`
+	AppraisedValue           *FlexFloat `json:"apprsdTotValue,omitempty" xml:"apprsdTotValue,omitempty"`
 }
 
 // AssessmentHistoryRecord contains historical assessment entries.
@@ -158,15 +162,16 @@ type AssessmentHistoryRecord struct {
 
 // Sale represents a single sale transaction for a property.
 type Sale struct {
-	SaleDate        *string  `json:"saleDate,omitempty"`
-	SaleSearchDate  *string  `json:"saleSearchDate,omitempty"`
-	RecordingDate   *string  `json:"recordingDate,omitempty"`
-	Amount          *float64 `json:"amount,omitempty"`
-	DocumentType    *string  `json:"documentType,omitempty"`
-	DocumentNumber  *string  `json:"documentNumber,omitempty"`
-	TransactionType *string  `json:"transactionType,omitempty"`
-	BuyerName       *string  `json:"buyerName,omitempty"`
-	SellerName      *string  `json:"sellerName,omitempty"`
+	SaleDate        *string   `json:"saleDate,omitempty" xml:"saleDate,omitempty"`
+	SaleSearchDate  *string   `json:"saleSearchDate,omitempty" xml:"saleSearchDate,omitempty"`
+	RecordingDate   *string   `json:"recordingDate,omitempty" xml:"recordingDate,omitempty"`
+	Amount          *float64  `json:"amount,omitempty" xml:"amount,omitempty"`
+	DocumentType    *string   `json:"documentType,omitempty" xml:"documentType,omitempty"`
+	DocumentNumber  *string   `json:"documentNumber,omitempty" xml:"documentNumber,omitempty"`
+	TransactionType *string   `json:"transactionType,omitempty" xml:"transactionType,omitempty"`
+	BuyerName       *string   `json:"buyerName,omitempty" xml:"buyerName,omitempty"`
+	SellerName      *string   `json:"sellerName,omitempty" xml:"sellerName,omitempty"`
+	Financing       *Mortgage `json:"mortgage,omitempty" xml:"mortgage,omitempty"`
 }
 
 // SalesHistoryRecord contains historical sales entries.
@@ -180,13 +185,13 @@ type SalesHistoryRecord struct {
 
 // AVM contains automated valuation model data.
 type AVM struct {
-	Value      *float64 `json:"value,omitempty"`
-	High       *float64 `json:"high,omitempty"`
-	Low        *float64 `json:"low,omitempty"`
-	Percentile *float64 `json:"percentile,omitempty"`
-	Score      *float64 `json:"score,omitempty"`
-	Confidence *string  `json:"confidence,omitempty"`
-	Updated    *string  `json:"updated,omitempty"`
+	Value      *float64   `json:"value,omitempty" xml:"value,omitempty"`
+	High       *float64   `json:"high,omitempty" xml:"high,omitempty"`
+	Low        *float64   `json:"low,omitempty" xml:"low,omitempty"`
+	Percentile *FlexFloat `json:"percentile,omitempty" xml:"percentile,omitempty"`
+	Score      *FlexFloat `json:"score,omitempty" xml:"score,omitempty"`
+	Confidence *string    `json:"confidence,omitempty" xml:"confidence,omitempty"`
+	Updated    *string    `json:"updated,omitempty" xml:"updated,omitempty"`
 }
 
 // AVMHistoryRecord describes valuation history entries.
@@ -204,36 +209,48 @@ type RentalAVM struct {
 	UpdatedDate *string  `json:"updatedDate,omitempty"`
 }
 
+// ExpandedSalesHistoryRecord contains a sales history entry from the expanded
+// history endpoint, adding the parties, title company, and loans recorded with
+// the sale.
+type ExpandedSalesHistoryRecord struct {
+	SalesHistoryRecord
+	TransactionType *string     `json:"transactionType,omitempty"`
+	BuyerName       *string     `json:"buyerName,omitempty"`
+	SellerName      *string     `json:"sellerName,omitempty"`
+	TitleCompany    *string     `json:"titleCompany,omitempty"`
+	Financing       []*Mortgage `json:"mortgage,omitempty"`
+}
+
 // Mortgage contains mortgage-related details for a property.
 type Mortgage struct {
-	LenderName    *string  `json:"lenderName,omitempty"`
-	LoanType      *string  `json:"loanType,omitempty"`
-	LoanAmount    *float64 `json:"loanAmount,omitempty"`
-	LoanDate      *string  `json:"loanDate,omitempty"`
-	InterestRate  *float64 `json:"interestRate,omitempty"`
-	MaturityDate  *string  `json:"maturityDate,omitempty"`
-	DueDate       *string  `json:"dueDate,omitempty"`
-	RecordingDate *string  `json:"recordingDate,omitempty"`
-	LoanNumber    *string  `json:"loanNumber,omitempty"`
-	MortgageType  *string  `json:"mortgageType,omitempty"`
+	LenderName    *string    `json:"lenderName,omitempty" xml:"lenderName,omitempty"`
+	LoanType      *string    `json:"loanType,omitempty" xml:"loanType,omitempty"`
+	LoanAmount    *float64   `json:"loanAmount,omitempty" xml:"loanAmount,omitempty"`
+	LoanDate      *string    `json:"loanDate,omitempty" xml:"loanDate,omitempty"`
+	InterestRate  *FlexFloat `json:"interestRate,omitempty" xml:"interestRate,omitempty"`
+	MaturityDate  *string    `json:"maturityDate,omitempty" xml:"maturityDate,omitempty"`
+	DueDate       *string    `json:"dueDate,omitempty" xml:"dueDate,omitempty"`
+	RecordingDate *string    `json:"recordingDate,omitempty" xml:"recordingDate,omitempty"`
+	LoanNumber    *string    `json:"loanNumber,omitempty" xml:"loanNumber,omitempty"`
+	MortgageType  *string    `json:"mortgageType,omitempty" xml:"mortgageType,omitempty"`
 }
 
 // Ownership represents owner information for a property.
 type Ownership struct {
-	OwnerType       *string  `json:"ownerType,omitempty"`
-	Owner1FirstName *string  `json:"owner1FirstName,omitempty"`
-	Owner1LastName  *string  `json:"owner1LastName,omitempty"`
-	Owner2FirstName *string  `json:"owner2FirstName,omitempty"`
-	Owner2LastName  *string  `json:"owner2LastName,omitempty"`
-	MailingAddress  *Address `json:"mailingAddress,omitempty"`
-	OccupancyStatus *string  `json:"occupancyStatus,omitempty"`
+	OwnerType       *string  `json:"ownerType,omitempty" xml:"ownerType,omitempty"`
+	Owner1FirstName *string  `json:"owner1FirstName,omitempty" xml:"owner1FirstName,omitempty"`
+	Owner1LastName  *string  `json:"owner1LastName,omitempty" xml:"owner1LastName,omitempty"`
+	Owner2FirstName *string  `json:"owner2FirstName,omitempty" xml:"owner2FirstName,omitempty"`
+	Owner2LastName  *string  `json:"owner2LastName,omitempty" xml:"owner2LastName,omitempty"`
+	MailingAddress  *Address `json:"mailingAddress,omitempty" xml:"mailingAddress,omitempty"`
+	OccupancyStatus *string  `json:"occupancyStatus,omitempty" xml:"occupancyStatus,omitempty"`
 }
 
 // Tax captures current tax data for a property.
 type Tax struct {
-	PaidAmount *float64 `json:"paidAmount,omitempty"`
-	TaxYear    *int     `json:"taxYear,omitempty"`
-	Delinquent *bool    `json:"delinquent,omitempty"`
+	PaidAmount *float64  `json:"paidAmount,omitempty" xml:"paidAmount,omitempty"`
+	TaxYear    *int      `json:"taxYear,omitempty" xml:"taxYear,omitempty"`
+	Delinquent *FlexBool `json:"delinquent,omitempty" xml:"delinquent,omitempty"`
 }
 
 // BuildingPermit represents a single permit record associated with a property.
@@ -248,23 +265,23 @@ type BuildingPermit struct {
 
 // School summarizes a school entity used within school endpoints.
 type School struct {
-	SchoolID        *string        `json:"schoolId,omitempty"`
-	Name            *string        `json:"name,omitempty"`
-	Type            *string        `json:"type,omitempty"`
-	GradeLow        *string        `json:"gradeLow,omitempty"`
-	GradeHigh       *string        `json:"gradeHigh,omitempty"`
-	Enrollment      *int           `json:"enrollment,omitempty"`
-	Phone           *string        `json:"phone,omitempty"`
-	DistanceInMiles *float64       `json:"distanceInMiles,omitempty"`
-	Address         *Address       `json:"address,omitempty"`
-	Ratings         *SchoolRatings `json:"ratings,omitempty"`
+	SchoolID        *string        `json:"schoolId,omitempty" xml:"schoolId,omitempty"`
+	Name            *string        `json:"name,omitempty" xml:"name,omitempty"`
+	Type            *string        `json:"type,omitempty" xml:"type,omitempty"`
+	GradeLow        *string        `json:"gradeLow,omitempty" xml:"gradeLow,omitempty"`
+	GradeHigh       *string        `json:"gradeHigh,omitempty" xml:"gradeHigh,omitempty"`
+	Enrollment      *int           `json:"enrollment,omitempty" xml:"enrollment,omitempty"`
+	Phone           *string        `json:"phone,omitempty" xml:"phone,omitempty"`
+	DistanceInMiles *float64       `json:"distanceInMiles,omitempty" xml:"distanceInMiles,omitempty"`
+	Address         *Address       `json:"address,omitempty" xml:"address,omitempty"`
+	Ratings         *SchoolRatings `json:"ratings,omitempty" xml:"ratings,omitempty"`
 }
 
 // SchoolRatings holds rating information for a school.
 type SchoolRatings struct {
-	Overall *float64 `json:"overall,omitempty"`
-	Test    *float64 `json:"test,omitempty"`
-	Equity  *float64 `json:"equity,omitempty"`
+	Overall *float64 `json:"overall,omitempty" xml:"overall,omitempty"`
+	Test    *float64 `json:"test,omitempty" xml:"test,omitempty"`
+	Equity  *float64 `json:"equity,omitempty" xml:"equity,omitempty"`
 }
 
 // SchoolDistrict represents school district details.
@@ -296,19 +313,19 @@ type AllEventsRecord struct {
 
 // Property encapsulates the full property data structure.
 type Property struct {
-	Identifier *Identifier  `json:"identifier,omitempty"`
-	Address    *Address     `json:"address,omitempty"`
-	Location   *GeoLocation `json:"location,omitempty"`
-	Lot        *Lot         `json:"lot,omitempty"`
-	Summary    *Summary     `json:"summary,omitempty"`
-	Building   *Building    `json:"building,omitempty"`
-	Assessment *Assessment  `json:"assessment,omitempty"`
-	Sale       *Sale        `json:"sale,omitempty"`
-	AVM        *AVM         `json:"avm,omitempty"`
-	Mortgage   []Mortgage   `json:"mortgage,omitempty"`
-	Ownership  *Ownership   `json:"ownership,omitempty"`
-	Tax        *Tax         `json:"tax,omitempty"`
-	Schools    []School     `json:"schools,omitempty"`
+	Identifier *Identifier  `json:"identifier,omitempty" xml:"identifier,omitempty"`
+	Address    *Address     `json:"address,omitempty" xml:"address,omitempty"`
+	Location   *GeoLocation `json:"location,omitempty" xml:"location,omitempty"`
+	Lot        *Lot         `json:"lot,omitempty" xml:"lot,omitempty"`
+	Summary    *Summary     `json:"summary,omitempty" xml:"summary,omitempty"`
+	Building   *Building    `json:"building,omitempty" xml:"building,omitempty"`
+	Assessment *Assessment  `json:"assessment,omitempty" xml:"assessment,omitempty"`
+	Sale       *Sale        `json:"sale,omitempty" xml:"sale,omitempty"`
+	AVM        *AVM         `json:"avm,omitempty" xml:"avm,omitempty"`
+	Mortgage   []Mortgage   `json:"mortgage,omitempty" xml:"mortgage,omitempty"`
+	Ownership  *Ownership   `json:"ownership,omitempty" xml:"ownership,omitempty"`
+	Tax        *Tax         `json:"tax,omitempty" xml:"tax,omitempty"`
+	Schools    []School     `json:"schools,omitempty" xml:"schools,omitempty"`
 }
 
 // IDResponse wraps the /property/id endpoint response.
@@ -317,10 +334,12 @@ type IDResponse struct {
 	Identifier []*Identifier `json:"identifier,omitempty"`
 }
 
-// DetailResponse wraps detailed property data.
+// DetailResponse wraps detailed property data. It carries xml tags alongside its
+// json tags, as do the models it contains, so it can also be decoded from XML
+// responses requested with WithAcceptFormat.
 type DetailResponse struct {
-	Status   *Status     `json:"status,omitempty"`
-	Property []*Property `json:"property,omitempty"`
+	Status   *Status     `json:"status,omitempty" xml:"status,omitempty"`
+	Property []*Property `json:"property,omitempty" xml:"property,omitempty"`
 }
 
 // AddressResponse wraps address-only responses.
@@ -341,6 +360,12 @@ type ProfileResponse struct {
 	Property []*Property `json:"property,omitempty"`
 }
 
+// ExpandedProfileWithSchools combines an expanded profile with the property's schools.
+type ExpandedProfileWithSchools struct {
+	Profile *ProfileResponse
+	Schools []*School
+}
+
 // WithSchoolsResponse extends property data with school assignments.
 type WithSchoolsResponse struct {
 	Status   *Status     `json:"status,omitempty"`
@@ -349,10 +374,12 @@ type WithSchoolsResponse struct {
 }
 
 // MortgageResponse extends property data with mortgage information.
+// MortgageHistory is populated only when WithMortgageHistory(true) is supplied.
 type MortgageResponse struct {
-	Status   *Status     `json:"status,omitempty"`
-	Property []*Property `json:"property,omitempty"`
-	Mortgage []*Mortgage `json:"mortgage,omitempty"`
+	Status          *Status     `json:"status,omitempty"`
+	Property        []*Property `json:"property,omitempty"`
+	Mortgage        []*Mortgage `json:"mortgage,omitempty"`
+	MortgageHistory []*Mortgage `json:"mortgageHistory,omitempty"`
 }
 
 // OwnerResponse extends property data with ownership information.
@@ -436,6 +463,12 @@ type SalesHistoryResponse struct {
 	Sales  []*SalesHistoryRecord `json:"salesHistory,omitempty"`
 }
 
+// ExpandedSalesHistoryResponse provides expanded sales history data.
+type ExpandedSalesHistoryResponse struct {
+	Status *Status                       `json:"status,omitempty"`
+	Sales  []*ExpandedSalesHistoryRecord `json:"salesHistory,omitempty"`
+}
+
 // SalesTrendSnapshotResponse wraps snapshot trend data.
 type SalesTrendSnapshotResponse struct {
 	Status *Status             `json:"status,omitempty"`
@@ -675,12 +708,75 @@ type CommunityResponse struct {
 
 // Community represents neighborhood community data.
 type Community struct {
-	ID          *string      `json:"id,omitempty"`
-	Name        *string      `json:"name,omitempty"`
-	Type        *string      `json:"type,omitempty"`
-	Description *string      `json:"description,omitempty"`
-	GeoLocation *GeoLocation `json:"geoLocation,omitempty"`
-	Boundary    *Boundary    `json:"boundary,omitempty"`
+	ID          *string          `json:"id,omitempty"`
+	Name        *string          `json:"name,omitempty"`
+	Type        *string          `json:"type,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	GeoLocation *GeoLocation     `json:"geoLocation,omitempty"`
+	Boundary    *Boundary        `json:"boundary,omitempty"`
+	Scores      *CommunityScores `json:"neighborhood,omitempty"`
+}
+
+// CommunityScores groups the index scores that community responses nest under the
+// neighborhood object. Index scores are relative to a national average of 100.
+type CommunityScores struct {
+	Crime            *CrimeScores           `json:"crime,omitempty"`
+	Affordability    *AffordabilityScores   `json:"affordability,omitempty"`
+	Commute          *CommuteScores         `json:"commute,omitempty"`
+	AirQuality       *AirQualityScores      `json:"airQuality,omitempty"`
+	NaturalDisasters *NaturalDisasterScores `json:"naturalDisasters,omitempty"`
+}
+
+// CrimeScores holds county-level crime indices.
+type CrimeScores struct {
+	CrimeIndex             *float64 `json:"crime_Index,omitempty"`
+	MurderIndex            *float64 `json:"murder_Index,omitempty"`
+	ForcibleRapeIndex      *float64 `json:"forcible_Rape_Index,omitempty"`
+	ForcibleRobberyIndex   *float64 `json:"forcible_Robbery_Index,omitempty"`
+	AggravatedAssaultIndex *float64 `json:"aggravated_Assault_Index,omitempty"`
+	BurglaryIndex          *float64 `json:"burglary_Index,omitempty"`
+	LarcenyIndex           *float64 `json:"larceny_Index,omitempty"`
+	MotorVehicleTheftIndex *float64 `json:"motor_Vehicle_Theft_Index,omitempty"`
+	MortalityIndex         *float64 `json:"mortality_Index,omitempty"`
+}
+
+// AirQualityScores holds air pollutant indices.
+type AirQualityScores struct {
+	AirPollutionIndex      *float64 `json:"air_Pollution_Index,omitempty"`
+	OzoneIndex             *float64 `json:"ozone_Index,omitempty"`
+	LeadIndex              *float64 `json:"lead_Index,omitempty"`
+	CarbonMonoxideIndex    *float64 `json:"carbon_Monoxide_Index,omitempty"`
+	NitrogenDioxideIndex   *float64 `json:"nitrogen_Dioxide_Index,omitempty"`
+	ParticulateMatterIndex *float64 `json:"particulate_Matter_Index,omitempty"`
+}
+
+// NaturalDisasterScores holds natural hazard indices.
+type NaturalDisasterScores struct {
+	WeatherIndex    *float64 `json:"weather_Index,omitempty"`
+	EarthquakeIndex *float64 `json:"earthquake_Index,omitempty"`
+	HailIndex       *float64 `json:"hail_Index,omitempty"`
+	HurricaneIndex  *float64 `json:"hurricane_Index,omitempty"`
+	TornadoIndex    *float64 `json:"tornado_Index,omitempty"`
+	WindIndex       *float64 `json:"wind_Index,omitempty"`
+}
+
+// AffordabilityScores holds housing cost and income figures for the area.
+type AffordabilityScores struct {
+	AffordabilityIndex    *float64 `json:"affordability_Index,omitempty"`
+	MedianHouseholdIncome *float64 `json:"median_Household_Income,omitempty"`
+	MedianHomeValue       *float64 `json:"median_Home_Value,omitempty"`
+	MedianGrossRent       *float64 `json:"median_Gross_Rent,omitempty"`
+}
+
+// CommuteScores holds travel-time-to-work figures. Percentages describe the share
+// of workers in each commute band.
+type CommuteScores struct {
+	MedianTravelTimeToWorkMinutes *float64 `json:"median_Travel_Time_To_Work_Mi,omitempty"`
+	TravelTime0To14Pct            *float64 `json:"travel_Time_To_Work_0_14_Mi_Pct,omitempty"`
+	TravelTime15To29Pct           *float64 `json:"travel_Time_To_Work_15_29_Mi_Pct,omitempty"`
+	TravelTime30To59Pct           *float64 `json:"travel_Time_To_Work_30_59_Mi_Pct,omitempty"`
+	TravelTime60To89Pct           *float64 `json:"travel_Time_To_Work_60_89_Mi_Pct,omitempty"`
+	TravelTime90PlusPct           *float64 `json:"travel_Time_To_Work_90_Or_More_Mi_Pct,omitempty"`
 }
 
 // LocationLookupResponse wraps location lookup data.
@@ -729,31 +825,40 @@ type TransportationNoise struct {
 }
 
 // ParcelTilesResponse wraps parcel tiles data.
+//
+// Deprecated: the parcel tile endpoint returns binary tiles, not JSON;
+// GetParcelTiles now returns the raw bytes.
 type ParcelTilesResponse struct {
 	Status      *Status       `json:"status,omitempty"`
 	ParcelTiles []*ParcelTile `json:"parcelTile,omitempty"`
 }
 
 // ParcelTile represents parcel tile data.
+//
+// Deprecated: see ParcelTilesResponse.
 type ParcelTile struct {
 	TileID *string `json:"tileId,omitempty"`
 	Format *string `json:"format,omitempty"`
 	Data   []byte  `json:"data,omitempty"`
 }
 
-// PreforeclosureResponse wraps pre-foreclosure details data.
-type PreforeclosureResponse struct {
-	Status         *Status           `json:"status,omitempty"`
-	Preforeclosure []*Preforeclosure `json:"preforeclosure,omitempty"`
+// ParcelTileData holds the raw bytes of a parcel tile along with the caching
+// metadata needed for conditional requests.
+type ParcelTileData struct {
+	Data        []byte
+	ContentType string
+	ETag        string
 }
 
-// Preforeclosure represents pre-foreclosure data.
-type Preforeclosure struct {
-	PropertyID      *string  `json:"propertyId,omitempty"`
-	ForeclosureType *string  `json:"foreclosureType,omitempty"`
-	Status          *string  `json:"status,omitempty"`
-	Amount          *float64 `json:"amount,omitempty"`
-	DateFiled       *string  `json:"dateFiled,omitempty"`
+// ProfileBundle merges the property, assessment, AVM, and sale detail of a single
+// property, as returned by GetPropertyProfileBundle. Fields whose request failed
+// or returned no records are nil.
+type ProfileBundle struct {
+	Identifier *Identifier
+	Address    *Address
+	Assessment *Assessment
+	AVM        *AVM
+	Sale       *Sale
 }
 
 // PreforeclosureDetailsResponse wraps pre-foreclosure details data.
@@ -762,11 +867,40 @@ type PreforeclosureDetailsResponse struct {
 	PreforeclosureDetails []*PreforeclosureDetail `json:"preforeclosureDetail,omitempty"`
 }
 
-// PreforeclosureDetail represents pre-foreclosure detail data.
+// PreforeclosureDetail represents a pre-foreclosure filing, including the default,
+// the trustee handling the sale, and any scheduled auction.
 type PreforeclosureDetail struct {
-	PropertyID    *string `json:"propertyId,omitempty"`
-	ForeclosureID *string `json:"foreclosureId,omitempty"`
-	Status        *string `json:"status,omitempty"`
-	FilingDate    *string `json:"filingDate,omitempty"`
-	Amount        *string `json:"amount,omitempty"`
-}
+	PropertyID         *string    `json:"propertyId,omitempty"`
+	ForeclosureID      *string    `json:"foreclosureId,omitempty"`
+	ForeclosureType    *string    `json:"foreclosureType,omitempty"`
+	Status             *string    `json:"status,omitempty"`
+	FilingDate         *string    `json:"filingDate,omitempty"`
+	RecordingDate      *string    `json:"recordingDate,omitempty"`
+	DocumentType       *string    `json:"documentType,omitempty"`
+	DocumentNumber     *string    `json:"documentNumber,omitempty"`
+	CaseNumber         *string    `json:"caseNumber,omitempty"`
+	Amount             *FlexFloat `json:"amount,omitempty"`
+	DefaultAmount      *FlexFloat `json:"defaultAmount,omitempty"`
+	DefaultDate        *string    `json:"defaultDate,omitempty"`
+	OriginalLoanAmount *FlexFloat `json:"originalLoanAmount,omitempty"`
+	LoanBalance        *FlexFloat `json:"loanBalance,omitempty"`
+	LenderName         *string    `json:"lenderName,omitempty"`
+	BorrowerName       *string    `json:"borrowerName,omitempty"`
+	TrusteeName        *string    `json:"trusteeName,omitempty"`
+	TrusteeAddress     *string    `json:"trusteeAddress,omitempty"`
+	TrusteePhone       *string    `json:"trusteePhone,omitempty"`
+	AuctionDate        *string    `json:"auctionDate,omitempty"`
+	AuctionTime        *string    `json:"auctionTime,omitempty"`
+	AuctionLocation    *string    `json:"auctionLocation,omitempty"`
+	OpeningBid         *FlexFloat `json:"openingBid,omitempty"`
+}
+
+// PreforeclosureResponse is the former name of PreforeclosureDetailsResponse.
+//
+// Deprecated: use PreforeclosureDetailsResponse.
+type PreforeclosureResponse = PreforeclosureDetailsResponse
+
+// Preforeclosure is the former name of PreforeclosureDetail.
+//
+// Deprecated: use PreforeclosureDetail.
+type Preforeclosure = PreforeclosureDetail
@@ -0,0 +1,3541 @@
+// Code generated by gen-accessors.go; DO NOT EDIT.
+
+package property
+
+import "encoding/json"
+
+// GetVersion returns the Version field, or the zero value if Status or the field is nil.
+func (s *Status) GetVersion() string {
+	if s == nil || s.Version == nil {
+		return ""
+	}
+	return *s.Version
+}
+
+// GetCode returns the Code field, or the zero value if Status or the field is nil.
+func (s *Status) GetCode() int {
+	if s == nil || s.Code == nil {
+		return 0
+	}
+	return *s.Code
+}
+
+// GetMsg returns the Msg field, or the zero value if Status or the field is nil.
+func (s *Status) GetMsg() string {
+	if s == nil || s.Msg == nil {
+		return ""
+	}
+	return *s.Msg
+}
+
+// GetTotal returns the Total field, or the zero value if Status or the field is nil.
+func (s *Status) GetTotal() int {
+	if s == nil || s.Total == nil {
+		return 0
+	}
+	return *s.Total
+}
+
+// GetPage returns the Page field, or the zero value if Status or the field is nil.
+func (s *Status) GetPage() int {
+	if s == nil || s.Page == nil {
+		return 0
+	}
+	return *s.Page
+}
+
+// GetPageSize returns the PageSize field, or the zero value if Status or the field is nil.
+func (s *Status) GetPageSize() int {
+	if s == nil || s.PageSize == nil {
+		return 0
+	}
+	return *s.PageSize
+}
+
+// GetTransactionID returns the TransactionID field, or the zero value if Status or the field is nil.
+func (s *Status) GetTransactionID() string {
+	if s == nil || s.TransactionID == nil {
+		return ""
+	}
+	return *s.TransactionID
+}
+
+// GetAttomID returns the AttomID field, or the zero value if Identifier or the field is nil.
+func (i *Identifier) GetAttomID() string {
+	if i == nil || i.AttomID == nil {
+		return ""
+	}
+	return *i.AttomID
+}
+
+// GetID returns the ID field, or the zero value if Identifier or the field is nil.
+func (i *Identifier) GetID() string {
+	if i == nil || i.ID == nil {
+		return ""
+	}
+	return *i.ID
+}
+
+// GetFIPS returns the FIPS field, or the zero value if Identifier or the field is nil.
+func (i *Identifier) GetFIPS() string {
+	if i == nil || i.FIPS == nil {
+		return ""
+	}
+	return *i.FIPS
+}
+
+// GetAPN returns the APN field, or the zero value if Identifier or the field is nil.
+func (i *Identifier) GetAPN() string {
+	if i == nil || i.APN == nil {
+		return ""
+	}
+	return *i.APN
+}
+
+// GetObPropID returns the ObPropID field, or the zero value if Identifier or the field is nil.
+func (i *Identifier) GetObPropID() string {
+	if i == nil || i.ObPropID == nil {
+		return ""
+	}
+	return *i.ObPropID
+}
+
+// GetLine1 returns the Line1 field, or the zero value if Address or the field is nil.
+func (a *Address) GetLine1() string {
+	if a == nil || a.Line1 == nil {
+		return ""
+	}
+	return *a.Line1
+}
+
+// GetLine2 returns the Line2 field, or the zero value if Address or the field is nil.
+func (a *Address) GetLine2() string {
+	if a == nil || a.Line2 == nil {
+		return ""
+	}
+	return *a.Line2
+}
+
+// GetCity returns the City field, or the zero value if Address or the field is nil.
+func (a *Address) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return *a.City
+}
+
+// GetState returns the State field, or the zero value if Address or the field is nil.
+func (a *Address) GetState() string {
+	if a == nil || a.State == nil {
+		return ""
+	}
+	return *a.State
+}
+
+// GetCounty returns the County field, or the zero value if Address or the field is nil.
+func (a *Address) GetCounty() string {
+	if a == nil || a.County == nil {
+		return ""
+	}
+	return *a.County
+}
+
+// GetCountry returns the Country field, or the zero value if Address or the field is nil.
+func (a *Address) GetCountry() string {
+	if a == nil || a.Country == nil {
+		return ""
+	}
+	return *a.Country
+}
+
+// GetPostalCode returns the PostalCode field, or the zero value if Address or the field is nil.
+func (a *Address) GetPostalCode() string {
+	if a == nil || a.PostalCode == nil {
+		return ""
+	}
+	return *a.PostalCode
+}
+
+// GetUnitNumber returns the UnitNumber field, or the zero value if Address or the field is nil.
+func (a *Address) GetUnitNumber() string {
+	if a == nil || a.UnitNumber == nil {
+		return ""
+	}
+	return *a.UnitNumber
+}
+
+// GetLatitude returns the Latitude field, or the zero value if Address or the field is nil.
+func (a *Address) GetLatitude() float64 {
+	if a == nil || a.Latitude == nil {
+		return 0
+	}
+	return *a.Latitude
+}
+
+// GetLongitude returns the Longitude field, or the zero value if Address or the field is nil.
+func (a *Address) GetLongitude() float64 {
+	if a == nil || a.Longitude == nil {
+		return 0
+	}
+	return *a.Longitude
+}
+
+// GetLatitude returns the Latitude field, or the zero value if GeoLocation or the field is nil.
+func (g *GeoLocation) GetLatitude() float64 {
+	if g == nil || g.Latitude == nil {
+		return 0
+	}
+	return *g.Latitude
+}
+
+// GetLongitude returns the Longitude field, or the zero value if GeoLocation or the field is nil.
+func (g *GeoLocation) GetLongitude() float64 {
+	if g == nil || g.Longitude == nil {
+		return 0
+	}
+	return *g.Longitude
+}
+
+// GetMatchCode returns the MatchCode field, or the zero value if GeoLocation or the field is nil.
+func (g *GeoLocation) GetMatchCode() string {
+	if g == nil || g.MatchCode == nil {
+		return ""
+	}
+	return *g.MatchCode
+}
+
+// GetQuality returns the Quality field, or the zero value if GeoLocation or the field is nil.
+func (g *GeoLocation) GetQuality() string {
+	if g == nil || g.Quality == nil {
+		return ""
+	}
+	return *g.Quality
+}
+
+// GetAcres returns the Acres field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetAcres() float64 {
+	if l == nil || l.Acres == nil {
+		return 0
+	}
+	return *l.Acres
+}
+
+// GetDepth returns the Depth field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetDepth() float64 {
+	if l == nil || l.Depth == nil {
+		return 0
+	}
+	return *l.Depth
+}
+
+// GetFrontage returns the Frontage field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetFrontage() float64 {
+	if l == nil || l.Frontage == nil {
+		return 0
+	}
+	return *l.Frontage
+}
+
+// GetAreaSquareFeet returns the AreaSquareFeet field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetAreaSquareFeet() float64 {
+	if l == nil || l.AreaSquareFeet == nil {
+		return 0
+	}
+	return *l.AreaSquareFeet
+}
+
+// GetLotNumber returns the LotNumber field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetLotNumber() string {
+	if l == nil || l.LotNumber == nil {
+		return ""
+	}
+	return *l.LotNumber
+}
+
+// GetRange returns the Range field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetRange() string {
+	if l == nil || l.Range == nil {
+		return ""
+	}
+	return *l.Range
+}
+
+// GetSection returns the Section field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetSection() string {
+	if l == nil || l.Section == nil {
+		return ""
+	}
+	return *l.Section
+}
+
+// GetTownship returns the Township field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetTownship() string {
+	if l == nil || l.Township == nil {
+		return ""
+	}
+	return *l.Township
+}
+
+// GetShape returns the Shape field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetShape() string {
+	if l == nil || l.Shape == nil {
+		return ""
+	}
+	return *l.Shape
+}
+
+// GetZoning returns the Zoning field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetZoning() string {
+	if l == nil || l.Zoning == nil {
+		return ""
+	}
+	return *l.Zoning
+}
+
+// GetPool returns the Pool field, or the zero value if Lot or the field is nil.
+func (l *Lot) GetPool() string {
+	if l == nil || l.Pool == nil {
+		return ""
+	}
+	return *l.Pool
+}
+
+// GetPropertyType returns the PropertyType field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetPropertyType() string {
+	if s == nil || s.PropertyType == nil {
+		return ""
+	}
+	return *s.PropertyType
+}
+
+// GetPropertyTypeDescription returns the PropertyTypeDescription field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetPropertyTypeDescription() string {
+	if s == nil || s.PropertyTypeDescription == nil {
+		return ""
+	}
+	return *s.PropertyTypeDescription
+}
+
+// GetYearBuilt returns the YearBuilt field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetYearBuilt() int {
+	if s == nil || s.YearBuilt == nil {
+		return 0
+	}
+	return *s.YearBuilt
+}
+
+// GetEffectiveYearBuilt returns the EffectiveYearBuilt field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetEffectiveYearBuilt() int {
+	if s == nil || s.EffectiveYearBuilt == nil {
+		return 0
+	}
+	return *s.EffectiveYearBuilt
+}
+
+// GetStories returns the Stories field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetStories() float64 {
+	if s == nil || s.Stories == nil {
+		return 0
+	}
+	return *s.Stories
+}
+
+// GetUnitsCount returns the UnitsCount field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetUnitsCount() int {
+	if s == nil || s.UnitsCount == nil {
+		return 0
+	}
+	return *s.UnitsCount
+}
+
+// GetLegalDescription returns the LegalDescription field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetLegalDescription() string {
+	if s == nil || s.LegalDescription == nil {
+		return ""
+	}
+	return *s.LegalDescription
+}
+
+// GetPropertyIndicator returns the PropertyIndicator field, or the zero value if Summary or the field is nil.
+func (s *Summary) GetPropertyIndicator() int {
+	if s == nil || s.PropertyIndicator == nil {
+		return 0
+	}
+	return *s.PropertyIndicator
+}
+
+// GetConstruction returns the Construction field, or an empty Construction if Building or the field is nil.
+func (b *Building) GetConstruction() *Construction {
+	if b == nil || b.Construction == nil {
+		return &Construction{}
+	}
+	return b.Construction
+}
+
+// GetRooms returns the Rooms field, or an empty Rooms if Building or the field is nil.
+func (b *Building) GetRooms() *Rooms {
+	if b == nil || b.Rooms == nil {
+		return &Rooms{}
+	}
+	return b.Rooms
+}
+
+// GetArea returns the Area field, or an empty BuildingArea if Building or the field is nil.
+func (b *Building) GetArea() *BuildingArea {
+	if b == nil || b.Area == nil {
+		return &BuildingArea{}
+	}
+	return b.Area
+}
+
+// GetInterior returns the Interior field, or an empty Interior if Building or the field is nil.
+func (b *Building) GetInterior() *Interior {
+	if b == nil || b.Interior == nil {
+		return &Interior{}
+	}
+	return b.Interior
+}
+
+// GetExterior returns the Exterior field, or an empty Exterior if Building or the field is nil.
+func (b *Building) GetExterior() *Exterior {
+	if b == nil || b.Exterior == nil {
+		return &Exterior{}
+	}
+	return b.Exterior
+}
+
+// GetSummary returns the Summary field, or an empty BuildingSummary if Building or the field is nil.
+func (b *Building) GetSummary() *BuildingSummary {
+	if b == nil || b.Summary == nil {
+		return &BuildingSummary{}
+	}
+	return b.Summary
+}
+
+// GetFrameType returns the FrameType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetFrameType() string {
+	if c == nil || c.FrameType == nil {
+		return ""
+	}
+	return *c.FrameType
+}
+
+// GetFoundation returns the Foundation field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetFoundation() string {
+	if c == nil || c.Foundation == nil {
+		return ""
+	}
+	return *c.Foundation
+}
+
+// GetRoofCover returns the RoofCover field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetRoofCover() string {
+	if c == nil || c.RoofCover == nil {
+		return ""
+	}
+	return *c.RoofCover
+}
+
+// GetRoofType returns the RoofType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetRoofType() string {
+	if c == nil || c.RoofType == nil {
+		return ""
+	}
+	return *c.RoofType
+}
+
+// GetWallType returns the WallType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetWallType() string {
+	if c == nil || c.WallType == nil {
+		return ""
+	}
+	return *c.WallType
+}
+
+// GetFloorType returns the FloorType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetFloorType() string {
+	if c == nil || c.FloorType == nil {
+		return ""
+	}
+	return *c.FloorType
+}
+
+// GetCoolingType returns the CoolingType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetCoolingType() string {
+	if c == nil || c.CoolingType == nil {
+		return ""
+	}
+	return *c.CoolingType
+}
+
+// GetHeatingType returns the HeatingType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetHeatingType() string {
+	if c == nil || c.HeatingType == nil {
+		return ""
+	}
+	return *c.HeatingType
+}
+
+// GetConstructionType returns the ConstructionType field, or the zero value if Construction or the field is nil.
+func (c *Construction) GetConstructionType() string {
+	if c == nil || c.ConstructionType == nil {
+		return ""
+	}
+	return *c.ConstructionType
+}
+
+// GetTotalRooms returns the TotalRooms field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetTotalRooms() int {
+	if r == nil || r.TotalRooms == nil {
+		return 0
+	}
+	return *r.TotalRooms
+}
+
+// GetBeds returns the Beds field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBeds() int {
+	if r == nil || r.Beds == nil {
+		return 0
+	}
+	return *r.Beds
+}
+
+// GetBathsFull returns the BathsFull field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBathsFull() FlexFloat {
+	if r == nil || r.BathsFull == nil {
+		return 0
+	}
+	return *r.BathsFull
+}
+
+// GetBathsHalf returns the BathsHalf field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBathsHalf() FlexFloat {
+	if r == nil || r.BathsHalf == nil {
+		return 0
+	}
+	return *r.BathsHalf
+}
+
+// GetBathsThreeQuarter returns the BathsThreeQuarter field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBathsThreeQuarter() FlexFloat {
+	if r == nil || r.BathsThreeQuarter == nil {
+		return 0
+	}
+	return *r.BathsThreeQuarter
+}
+
+// GetBathsTotal returns the BathsTotal field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBathsTotal() FlexFloat {
+	if r == nil || r.BathsTotal == nil {
+		return 0
+	}
+	return *r.BathsTotal
+}
+
+// GetBathsCalculated returns the BathsCalculated field, or the zero value if Rooms or the field is nil.
+func (r *Rooms) GetBathsCalculated() FlexFloat {
+	if r == nil || r.BathsCalculated == nil {
+		return 0
+	}
+	return *r.BathsCalculated
+}
+
+// GetLivingSquareFeet returns the LivingSquareFeet field, or the zero value if BuildingArea or the field is nil.
+func (b *BuildingArea) GetLivingSquareFeet() FlexInt {
+	if b == nil || b.LivingSquareFeet == nil {
+		return 0
+	}
+	return *b.LivingSquareFeet
+}
+
+// GetTotalSquareFeet returns the TotalSquareFeet field, or the zero value if BuildingArea or the field is nil.
+func (b *BuildingArea) GetTotalSquareFeet() FlexInt {
+	if b == nil || b.TotalSquareFeet == nil {
+		return 0
+	}
+	return *b.TotalSquareFeet
+}
+
+// GetGarageSquareFeet returns the GarageSquareFeet field, or the zero value if BuildingArea or the field is nil.
+func (b *BuildingArea) GetGarageSquareFeet() FlexInt {
+	if b == nil || b.GarageSquareFeet == nil {
+		return 0
+	}
+	return *b.GarageSquareFeet
+}
+
+// GetBasementSquareFeet returns the BasementSquareFeet field, or the zero value if BuildingArea or the field is nil.
+func (b *BuildingArea) GetBasementSquareFeet() FlexInt {
+	if b == nil || b.BasementSquareFeet == nil {
+		return 0
+	}
+	return *b.BasementSquareFeet
+}
+
+// GetAtticSquareFeet returns the AtticSquareFeet field, or the zero value if BuildingArea or the field is nil.
+func (b *BuildingArea) GetAtticSquareFeet() FlexInt {
+	if b == nil || b.AtticSquareFeet == nil {
+		return 0
+	}
+	return *b.AtticSquareFeet
+}
+
+// GetFireplaceCount returns the FireplaceCount field, or the zero value if Interior or the field is nil.
+func (i *Interior) GetFireplaceCount() int {
+	if i == nil || i.FireplaceCount == nil {
+		return 0
+	}
+	return *i.FireplaceCount
+}
+
+// GetFlooringType returns the FlooringType field, or the zero value if Interior or the field is nil.
+func (i *Interior) GetFlooringType() string {
+	if i == nil || i.FlooringType == nil {
+		return ""
+	}
+	return *i.FlooringType
+}
+
+// GetLaundry returns the Laundry field, or the zero value if Interior or the field is nil.
+func (i *Interior) GetLaundry() string {
+	if i == nil || i.Laundry == nil {
+		return ""
+	}
+	return *i.Laundry
+}
+
+// GetGarageType returns the GarageType field, or the zero value if Exterior or the field is nil.
+func (e *Exterior) GetGarageType() string {
+	if e == nil || e.GarageType == nil {
+		return ""
+	}
+	return *e.GarageType
+}
+
+// GetParkingSpaces returns the ParkingSpaces field, or the zero value if Exterior or the field is nil.
+func (e *Exterior) GetParkingSpaces() int {
+	if e == nil || e.ParkingSpaces == nil {
+		return 0
+	}
+	return *e.ParkingSpaces
+}
+
+// GetPorchType returns the PorchType field, or the zero value if Exterior or the field is nil.
+func (e *Exterior) GetPorchType() string {
+	if e == nil || e.PorchType == nil {
+		return ""
+	}
+	return *e.PorchType
+}
+
+// GetPatioType returns the PatioType field, or the zero value if Exterior or the field is nil.
+func (e *Exterior) GetPatioType() string {
+	if e == nil || e.PatioType == nil {
+		return ""
+	}
+	return *e.PatioType
+}
+
+// GetQuality returns the Quality field, or the zero value if BuildingSummary or the field is nil.
+func (b *BuildingSummary) GetQuality() string {
+	if b == nil || b.Quality == nil {
+		return ""
+	}
+	return *b.Quality
+}
+
+// GetCondition returns the Condition field, or the zero value if BuildingSummary or the field is nil.
+func (b *BuildingSummary) GetCondition() string {
+	if b == nil || b.Condition == nil {
+		return ""
+	}
+	return *b.Condition
+}
+
+// GetArchitecturalStyle returns the ArchitecturalStyle field, or the zero value if BuildingSummary or the field is nil.
+func (b *BuildingSummary) GetArchitecturalStyle() string {
+	if b == nil || b.ArchitecturalStyle == nil {
+		return ""
+	}
+	return *b.ArchitecturalStyle
+}
+
+// GetPropClass returns the PropClass field, or the zero value if BuildingSummary or the field is nil.
+func (b *BuildingSummary) GetPropClass() string {
+	if b == nil || b.PropClass == nil {
+		return ""
+	}
+	return *b.PropClass
+}
+
+// GetAssessedTotalValue returns the AssessedTotalValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetAssessedTotalValue() FlexFloat {
+	if a == nil || a.AssessedTotalValue == nil {
+		return 0
+	}
+	return *a.AssessedTotalValue
+}
+
+// GetAssessedLandValue returns the AssessedLandValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetAssessedLandValue() FlexFloat {
+	if a == nil || a.AssessedLandValue == nil {
+		return 0
+	}
+	return *a.AssessedLandValue
+}
+
+// GetAssessedImprovementValue returns the AssessedImprovementValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetAssessedImprovementValue() FlexFloat {
+	if a == nil || a.AssessedImprovementValue == nil {
+		return 0
+	}
+	return *a.AssessedImprovementValue
+}
+
+// GetMarketTotalValue returns the MarketTotalValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetMarketTotalValue() FlexFloat {
+	if a == nil || a.MarketTotalValue == nil {
+		return 0
+	}
+	return *a.MarketTotalValue
+}
+
+// GetMarketLandValue returns the MarketLandValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetMarketLandValue() FlexFloat {
+	if a == nil || a.MarketLandValue == nil {
+		return 0
+	}
+	return *a.MarketLandValue
+}
+
+// GetMarketImprovementValue returns the MarketImprovementValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetMarketImprovementValue() FlexFloat {
+	if a == nil || a.MarketImprovementValue == nil {
+		return 0
+	}
+	return *a.MarketImprovementValue
+}
+
+// GetTaxAmount returns the TaxAmount field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetTaxAmount() FlexFloat {
+	if a == nil || a.TaxAmount == nil {
+		return 0
+	}
+	return *a.TaxAmount
+}
+
+// GetTaxYear returns the TaxYear field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetTaxYear() int {
+	if a == nil || a.TaxYear == nil {
+		return 0
+	}
+	return *a.TaxYear
+}
+
+// GetTaxRate returns the TaxRate field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetTaxRate() FlexFloat {
+	if a == nil || a.TaxRate == nil {
+		return 0
+	}
+	return *a.TaxRate
+}
+
+// GetAppraisedValue returns the AppraisedValue field, or the zero value if Assessment or the field is nil.
+func (a *Assessment) GetAppraisedValue() FlexFloat {
+	if a == nil || a.AppraisedValue == nil {
+		return 0
+	}
+	return *a.AppraisedValue
+}
+
+// GetCalendarYear returns the CalendarYear field, or the zero value if AssessmentHistoryRecord or the field is nil.
+func (a *AssessmentHistoryRecord) GetCalendarYear() int {
+	if a == nil || a.CalendarYear == nil {
+		return 0
+	}
+	return *a.CalendarYear
+}
+
+// GetAssessedValue returns the AssessedValue field, or the zero value if AssessmentHistoryRecord or the field is nil.
+func (a *AssessmentHistoryRecord) GetAssessedValue() float64 {
+	if a == nil || a.AssessedValue == nil {
+		return 0
+	}
+	return *a.AssessedValue
+}
+
+// GetTaxAmount returns the TaxAmount field, or the zero value if AssessmentHistoryRecord or the field is nil.
+func (a *AssessmentHistoryRecord) GetTaxAmount() float64 {
+	if a == nil || a.TaxAmount == nil {
+		return 0
+	}
+	return *a.TaxAmount
+}
+
+// GetSaleDate returns the SaleDate field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetSaleDate() string {
+	if s == nil || s.SaleDate == nil {
+		return ""
+	}
+	return *s.SaleDate
+}
+
+// GetSaleSearchDate returns the SaleSearchDate field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetSaleSearchDate() string {
+	if s == nil || s.SaleSearchDate == nil {
+		return ""
+	}
+	return *s.SaleSearchDate
+}
+
+// GetRecordingDate returns the RecordingDate field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetRecordingDate() string {
+	if s == nil || s.RecordingDate == nil {
+		return ""
+	}
+	return *s.RecordingDate
+}
+
+// GetAmount returns the Amount field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetAmount() float64 {
+	if s == nil || s.Amount == nil {
+		return 0
+	}
+	return *s.Amount
+}
+
+// GetDocumentType returns the DocumentType field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetDocumentType() string {
+	if s == nil || s.DocumentType == nil {
+		return ""
+	}
+	return *s.DocumentType
+}
+
+// GetDocumentNumber returns the DocumentNumber field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetDocumentNumber() string {
+	if s == nil || s.DocumentNumber == nil {
+		return ""
+	}
+	return *s.DocumentNumber
+}
+
+// GetTransactionType returns the TransactionType field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetTransactionType() string {
+	if s == nil || s.TransactionType == nil {
+		return ""
+	}
+	return *s.TransactionType
+}
+
+// GetBuyerName returns the BuyerName field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetBuyerName() string {
+	if s == nil || s.BuyerName == nil {
+		return ""
+	}
+	return *s.BuyerName
+}
+
+// GetSellerName returns the SellerName field, or the zero value if Sale or the field is nil.
+func (s *Sale) GetSellerName() string {
+	if s == nil || s.SellerName == nil {
+		return ""
+	}
+	return *s.SellerName
+}
+
+// GetFinancing returns the Financing field, or an empty Mortgage if Sale or the field is nil.
+func (s *Sale) GetFinancing() *Mortgage {
+	if s == nil || s.Financing == nil {
+		return &Mortgage{}
+	}
+	return s.Financing
+}
+
+// GetSaleDate returns the SaleDate field, or the zero value if SalesHistoryRecord or the field is nil.
+func (s *SalesHistoryRecord) GetSaleDate() string {
+	if s == nil || s.SaleDate == nil {
+		return ""
+	}
+	return *s.SaleDate
+}
+
+// GetSaleAmount returns the SaleAmount field, or the zero value if SalesHistoryRecord or the field is nil.
+func (s *SalesHistoryRecord) GetSaleAmount() float64 {
+	if s == nil || s.SaleAmount == nil {
+		return 0
+	}
+	return *s.SaleAmount
+}
+
+// GetDocumentType returns the DocumentType field, or the zero value if SalesHistoryRecord or the field is nil.
+func (s *SalesHistoryRecord) GetDocumentType() string {
+	if s == nil || s.DocumentType == nil {
+		return ""
+	}
+	return *s.DocumentType
+}
+
+// GetDocumentNumber returns the DocumentNumber field, or the zero value if SalesHistoryRecord or the field is nil.
+func (s *SalesHistoryRecord) GetDocumentNumber() string {
+	if s == nil || s.DocumentNumber == nil {
+		return ""
+	}
+	return *s.DocumentNumber
+}
+
+// GetRecordingDate returns the RecordingDate field, or the zero value if SalesHistoryRecord or the field is nil.
+func (s *SalesHistoryRecord) GetRecordingDate() string {
+	if s == nil || s.RecordingDate == nil {
+		return ""
+	}
+	return *s.RecordingDate
+}
+
+// GetValue returns the Value field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetValue() float64 {
+	if a == nil || a.Value == nil {
+		return 0
+	}
+	return *a.Value
+}
+
+// GetHigh returns the High field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetHigh() float64 {
+	if a == nil || a.High == nil {
+		return 0
+	}
+	return *a.High
+}
+
+// GetLow returns the Low field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetLow() float64 {
+	if a == nil || a.Low == nil {
+		return 0
+	}
+	return *a.Low
+}
+
+// GetPercentile returns the Percentile field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetPercentile() FlexFloat {
+	if a == nil || a.Percentile == nil {
+		return 0
+	}
+	return *a.Percentile
+}
+
+// GetScore returns the Score field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetScore() FlexFloat {
+	if a == nil || a.Score == nil {
+		return 0
+	}
+	return *a.Score
+}
+
+// GetConfidence returns the Confidence field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetConfidence() string {
+	if a == nil || a.Confidence == nil {
+		return ""
+	}
+	return *a.Confidence
+}
+
+// GetUpdated returns the Updated field, or the zero value if AVM or the field is nil.
+func (a *AVM) GetUpdated() string {
+	if a == nil || a.Updated == nil {
+		return ""
+	}
+	return *a.Updated
+}
+
+// GetDate returns the Date field, or the zero value if AVMHistoryRecord or the field is nil.
+func (a *AVMHistoryRecord) GetDate() string {
+	if a == nil || a.Date == nil {
+		return ""
+	}
+	return *a.Date
+}
+
+// GetValue returns the Value field, or the zero value if AVMHistoryRecord or the field is nil.
+func (a *AVMHistoryRecord) GetValue() float64 {
+	if a == nil || a.Value == nil {
+		return 0
+	}
+	return *a.Value
+}
+
+// GetHigh returns the High field, or the zero value if AVMHistoryRecord or the field is nil.
+func (a *AVMHistoryRecord) GetHigh() float64 {
+	if a == nil || a.High == nil {
+		return 0
+	}
+	return *a.High
+}
+
+// GetLow returns the Low field, or the zero value if AVMHistoryRecord or the field is nil.
+func (a *AVMHistoryRecord) GetLow() float64 {
+	if a == nil || a.Low == nil {
+		return 0
+	}
+	return *a.Low
+}
+
+// GetValue returns the Value field, or the zero value if RentalAVM or the field is nil.
+func (r *RentalAVM) GetValue() float64 {
+	if r == nil || r.Value == nil {
+		return 0
+	}
+	return *r.Value
+}
+
+// GetConfidence returns the Confidence field, or the zero value if RentalAVM or the field is nil.
+func (r *RentalAVM) GetConfidence() string {
+	if r == nil || r.Confidence == nil {
+		return ""
+	}
+	return *r.Confidence
+}
+
+// GetUpdatedDate returns the UpdatedDate field, or the zero value if RentalAVM or the field is nil.
+func (r *RentalAVM) GetUpdatedDate() string {
+	if r == nil || r.UpdatedDate == nil {
+		return ""
+	}
+	return *r.UpdatedDate
+}
+
+// GetTransactionType returns the TransactionType field, or the zero value if ExpandedSalesHistoryRecord or the field is nil.
+func (e *ExpandedSalesHistoryRecord) GetTransactionType() string {
+	if e == nil || e.TransactionType == nil {
+		return ""
+	}
+	return *e.TransactionType
+}
+
+// GetBuyerName returns the BuyerName field, or the zero value if ExpandedSalesHistoryRecord or the field is nil.
+func (e *ExpandedSalesHistoryRecord) GetBuyerName() string {
+	if e == nil || e.BuyerName == nil {
+		return ""
+	}
+	return *e.BuyerName
+}
+
+// GetSellerName returns the SellerName field, or the zero value if ExpandedSalesHistoryRecord or the field is nil.
+func (e *ExpandedSalesHistoryRecord) GetSellerName() string {
+	if e == nil || e.SellerName == nil {
+		return ""
+	}
+	return *e.SellerName
+}
+
+// GetTitleCompany returns the TitleCompany field, or the zero value if ExpandedSalesHistoryRecord or the field is nil.
+func (e *ExpandedSalesHistoryRecord) GetTitleCompany() string {
+	if e == nil || e.TitleCompany == nil {
+		return ""
+	}
+	return *e.TitleCompany
+}
+
+// GetFinancing returns the Financing field, or the zero value if ExpandedSalesHistoryRecord is nil.
+func (e *ExpandedSalesHistoryRecord) GetFinancing() []*Mortgage {
+	if e == nil {
+		return nil
+	}
+	return e.Financing
+}
+
+// GetLenderName returns the LenderName field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetLenderName() string {
+	if m == nil || m.LenderName == nil {
+		return ""
+	}
+	return *m.LenderName
+}
+
+// GetLoanType returns the LoanType field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetLoanType() string {
+	if m == nil || m.LoanType == nil {
+		return ""
+	}
+	return *m.LoanType
+}
+
+// GetLoanAmount returns the LoanAmount field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetLoanAmount() float64 {
+	if m == nil || m.LoanAmount == nil {
+		return 0
+	}
+	return *m.LoanAmount
+}
+
+// GetLoanDate returns the LoanDate field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetLoanDate() string {
+	if m == nil || m.LoanDate == nil {
+		return ""
+	}
+	return *m.LoanDate
+}
+
+// GetInterestRate returns the InterestRate field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetInterestRate() FlexFloat {
+	if m == nil || m.InterestRate == nil {
+		return 0
+	}
+	return *m.InterestRate
+}
+
+// GetMaturityDate returns the MaturityDate field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetMaturityDate() string {
+	if m == nil || m.MaturityDate == nil {
+		return ""
+	}
+	return *m.MaturityDate
+}
+
+// GetDueDate returns the DueDate field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetDueDate() string {
+	if m == nil || m.DueDate == nil {
+		return ""
+	}
+	return *m.DueDate
+}
+
+// GetRecordingDate returns the RecordingDate field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetRecordingDate() string {
+	if m == nil || m.RecordingDate == nil {
+		return ""
+	}
+	return *m.RecordingDate
+}
+
+// GetLoanNumber returns the LoanNumber field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetLoanNumber() string {
+	if m == nil || m.LoanNumber == nil {
+		return ""
+	}
+	return *m.LoanNumber
+}
+
+// GetMortgageType returns the MortgageType field, or the zero value if Mortgage or the field is nil.
+func (m *Mortgage) GetMortgageType() string {
+	if m == nil || m.MortgageType == nil {
+		return ""
+	}
+	return *m.MortgageType
+}
+
+// GetOwnerType returns the OwnerType field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOwnerType() string {
+	if o == nil || o.OwnerType == nil {
+		return ""
+	}
+	return *o.OwnerType
+}
+
+// GetOwner1FirstName returns the Owner1FirstName field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOwner1FirstName() string {
+	if o == nil || o.Owner1FirstName == nil {
+		return ""
+	}
+	return *o.Owner1FirstName
+}
+
+// GetOwner1LastName returns the Owner1LastName field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOwner1LastName() string {
+	if o == nil || o.Owner1LastName == nil {
+		return ""
+	}
+	return *o.Owner1LastName
+}
+
+// GetOwner2FirstName returns the Owner2FirstName field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOwner2FirstName() string {
+	if o == nil || o.Owner2FirstName == nil {
+		return ""
+	}
+	return *o.Owner2FirstName
+}
+
+// GetOwner2LastName returns the Owner2LastName field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOwner2LastName() string {
+	if o == nil || o.Owner2LastName == nil {
+		return ""
+	}
+	return *o.Owner2LastName
+}
+
+// GetMailingAddress returns the MailingAddress field, or an empty Address if Ownership or the field is nil.
+func (o *Ownership) GetMailingAddress() *Address {
+	if o == nil || o.MailingAddress == nil {
+		return &Address{}
+	}
+	return o.MailingAddress
+}
+
+// GetOccupancyStatus returns the OccupancyStatus field, or the zero value if Ownership or the field is nil.
+func (o *Ownership) GetOccupancyStatus() string {
+	if o == nil || o.OccupancyStatus == nil {
+		return ""
+	}
+	return *o.OccupancyStatus
+}
+
+// GetPaidAmount returns the PaidAmount field, or the zero value if Tax or the field is nil.
+func (t *Tax) GetPaidAmount() float64 {
+	if t == nil || t.PaidAmount == nil {
+		return 0
+	}
+	return *t.PaidAmount
+}
+
+// GetTaxYear returns the TaxYear field, or the zero value if Tax or the field is nil.
+func (t *Tax) GetTaxYear() int {
+	if t == nil || t.TaxYear == nil {
+		return 0
+	}
+	return *t.TaxYear
+}
+
+// GetDelinquent returns the Delinquent field, or the zero value if Tax or the field is nil.
+func (t *Tax) GetDelinquent() FlexBool {
+	if t == nil || t.Delinquent == nil {
+		return false
+	}
+	return *t.Delinquent
+}
+
+// GetPermitNumber returns the PermitNumber field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetPermitNumber() string {
+	if b == nil || b.PermitNumber == nil {
+		return ""
+	}
+	return *b.PermitNumber
+}
+
+// GetPermitType returns the PermitType field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetPermitType() string {
+	if b == nil || b.PermitType == nil {
+		return ""
+	}
+	return *b.PermitType
+}
+
+// GetPermitDate returns the PermitDate field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetPermitDate() string {
+	if b == nil || b.PermitDate == nil {
+		return ""
+	}
+	return *b.PermitDate
+}
+
+// GetDescription returns the Description field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetDescription() string {
+	if b == nil || b.Description == nil {
+		return ""
+	}
+	return *b.Description
+}
+
+// GetContractor returns the Contractor field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetContractor() string {
+	if b == nil || b.Contractor == nil {
+		return ""
+	}
+	return *b.Contractor
+}
+
+// GetValue returns the Value field, or the zero value if BuildingPermit or the field is nil.
+func (b *BuildingPermit) GetValue() float64 {
+	if b == nil || b.Value == nil {
+		return 0
+	}
+	return *b.Value
+}
+
+// GetSchoolID returns the SchoolID field, or the zero value if School or the field is nil.
+func (s *School) GetSchoolID() string {
+	if s == nil || s.SchoolID == nil {
+		return ""
+	}
+	return *s.SchoolID
+}
+
+// GetName returns the Name field, or the zero value if School or the field is nil.
+func (s *School) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetType returns the Type field, or the zero value if School or the field is nil.
+func (s *School) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// GetGradeLow returns the GradeLow field, or the zero value if School or the field is nil.
+func (s *School) GetGradeLow() string {
+	if s == nil || s.GradeLow == nil {
+		return ""
+	}
+	return *s.GradeLow
+}
+
+// GetGradeHigh returns the GradeHigh field, or the zero value if School or the field is nil.
+func (s *School) GetGradeHigh() string {
+	if s == nil || s.GradeHigh == nil {
+		return ""
+	}
+	return *s.GradeHigh
+}
+
+// GetEnrollment returns the Enrollment field, or the zero value if School or the field is nil.
+func (s *School) GetEnrollment() int {
+	if s == nil || s.Enrollment == nil {
+		return 0
+	}
+	return *s.Enrollment
+}
+
+// GetPhone returns the Phone field, or the zero value if School or the field is nil.
+func (s *School) GetPhone() string {
+	if s == nil || s.Phone == nil {
+		return ""
+	}
+	return *s.Phone
+}
+
+// GetDistanceInMiles returns the DistanceInMiles field, or the zero value if School or the field is nil.
+func (s *School) GetDistanceInMiles() float64 {
+	if s == nil || s.DistanceInMiles == nil {
+		return 0
+	}
+	return *s.DistanceInMiles
+}
+
+// GetAddress returns the Address field, or an empty Address if School or the field is nil.
+func (s *School) GetAddress() *Address {
+	if s == nil || s.Address == nil {
+		return &Address{}
+	}
+	return s.Address
+}
+
+// GetRatings returns the Ratings field, or an empty SchoolRatings if School or the field is nil.
+func (s *School) GetRatings() *SchoolRatings {
+	if s == nil || s.Ratings == nil {
+		return &SchoolRatings{}
+	}
+	return s.Ratings
+}
+
+// GetOverall returns the Overall field, or the zero value if SchoolRatings or the field is nil.
+func (s *SchoolRatings) GetOverall() float64 {
+	if s == nil || s.Overall == nil {
+		return 0
+	}
+	return *s.Overall
+}
+
+// GetTest returns the Test field, or the zero value if SchoolRatings or the field is nil.
+func (s *SchoolRatings) GetTest() float64 {
+	if s == nil || s.Test == nil {
+		return 0
+	}
+	return *s.Test
+}
+
+// GetEquity returns the Equity field, or the zero value if SchoolRatings or the field is nil.
+func (s *SchoolRatings) GetEquity() float64 {
+	if s == nil || s.Equity == nil {
+		return 0
+	}
+	return *s.Equity
+}
+
+// GetDistrictID returns the DistrictID field, or the zero value if SchoolDistrict or the field is nil.
+func (s *SchoolDistrict) GetDistrictID() string {
+	if s == nil || s.DistrictID == nil {
+		return ""
+	}
+	return *s.DistrictID
+}
+
+// GetName returns the Name field, or the zero value if SchoolDistrict or the field is nil.
+func (s *SchoolDistrict) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetType returns the Type field, or the zero value if SchoolDistrict or the field is nil.
+func (s *SchoolDistrict) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// GetPhone returns the Phone field, or the zero value if SchoolDistrict or the field is nil.
+func (s *SchoolDistrict) GetPhone() string {
+	if s == nil || s.Phone == nil {
+		return ""
+	}
+	return *s.Phone
+}
+
+// GetEnrollment returns the Enrollment field, or the zero value if SchoolDistrict or the field is nil.
+func (s *SchoolDistrict) GetEnrollment() int {
+	if s == nil || s.Enrollment == nil {
+		return 0
+	}
+	return *s.Enrollment
+}
+
+// GetGeoID returns the GeoID field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetGeoID() string {
+	if s == nil || s.GeoID == nil {
+		return ""
+	}
+	return *s.GeoID
+}
+
+// GetGeoIDV4 returns the GeoIDV4 field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetGeoIDV4() string {
+	if s == nil || s.GeoIDV4 == nil {
+		return ""
+	}
+	return *s.GeoIDV4
+}
+
+// GetPeriod returns the Period field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetPeriod() string {
+	if s == nil || s.Period == nil {
+		return ""
+	}
+	return *s.Period
+}
+
+// GetInterval returns the Interval field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetInterval() string {
+	if s == nil || s.Interval == nil {
+		return ""
+	}
+	return *s.Interval
+}
+
+// GetAvgSaleAmt returns the AvgSaleAmt field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetAvgSaleAmt() float64 {
+	if s == nil || s.AvgSaleAmt == nil {
+		return 0
+	}
+	return *s.AvgSaleAmt
+}
+
+// GetMedSaleAmt returns the MedSaleAmt field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetMedSaleAmt() float64 {
+	if s == nil || s.MedSaleAmt == nil {
+		return 0
+	}
+	return *s.MedSaleAmt
+}
+
+// GetSaleCount returns the SaleCount field, or the zero value if SalesTrendRecord or the field is nil.
+func (s *SalesTrendRecord) GetSaleCount() int {
+	if s == nil || s.SaleCount == nil {
+		return 0
+	}
+	return *s.SaleCount
+}
+
+// GetEventType returns the EventType field, or the zero value if AllEventsRecord or the field is nil.
+func (a *AllEventsRecord) GetEventType() string {
+	if a == nil || a.EventType == nil {
+		return ""
+	}
+	return *a.EventType
+}
+
+// GetEventDate returns the EventDate field, or the zero value if AllEventsRecord or the field is nil.
+func (a *AllEventsRecord) GetEventDate() string {
+	if a == nil || a.EventDate == nil {
+		return ""
+	}
+	return *a.EventDate
+}
+
+// GetRaw returns the Raw field, or the zero value if AllEventsRecord is nil.
+func (a *AllEventsRecord) GetRaw() json.RawMessage {
+	if a == nil {
+		return nil
+	}
+	return a.Raw
+}
+
+// GetIdentifier returns the Identifier field, or an empty Identifier if Property or the field is nil.
+func (p *Property) GetIdentifier() *Identifier {
+	if p == nil || p.Identifier == nil {
+		return &Identifier{}
+	}
+	return p.Identifier
+}
+
+// GetAddress returns the Address field, or an empty Address if Property or the field is nil.
+func (p *Property) GetAddress() *Address {
+	if p == nil || p.Address == nil {
+		return &Address{}
+	}
+	return p.Address
+}
+
+// GetLocation returns the Location field, or an empty GeoLocation if Property or the field is nil.
+func (p *Property) GetLocation() *GeoLocation {
+	if p == nil || p.Location == nil {
+		return &GeoLocation{}
+	}
+	return p.Location
+}
+
+// GetLot returns the Lot field, or an empty Lot if Property or the field is nil.
+func (p *Property) GetLot() *Lot {
+	if p == nil || p.Lot == nil {
+		return &Lot{}
+	}
+	return p.Lot
+}
+
+// GetSummary returns the Summary field, or an empty Summary if Property or the field is nil.
+func (p *Property) GetSummary() *Summary {
+	if p == nil || p.Summary == nil {
+		return &Summary{}
+	}
+	return p.Summary
+}
+
+// GetBuilding returns the Building field, or an empty Building if Property or the field is nil.
+func (p *Property) GetBuilding() *Building {
+	if p == nil || p.Building == nil {
+		return &Building{}
+	}
+	return p.Building
+}
+
+// GetAssessment returns the Assessment field, or an empty Assessment if Property or the field is nil.
+func (p *Property) GetAssessment() *Assessment {
+	if p == nil || p.Assessment == nil {
+		return &Assessment{}
+	}
+	return p.Assessment
+}
+
+// GetSale returns the Sale field, or an empty Sale if Property or the field is nil.
+func (p *Property) GetSale() *Sale {
+	if p == nil || p.Sale == nil {
+		return &Sale{}
+	}
+	return p.Sale
+}
+
+// GetAVM returns the AVM field, or an empty AVM if Property or the field is nil.
+func (p *Property) GetAVM() *AVM {
+	if p == nil || p.AVM == nil {
+		return &AVM{}
+	}
+	return p.AVM
+}
+
+// GetMortgage returns the Mortgage field, or the zero value if Property is nil.
+func (p *Property) GetMortgage() []Mortgage {
+	if p == nil {
+		return nil
+	}
+	return p.Mortgage
+}
+
+// GetOwnership returns the Ownership field, or an empty Ownership if Property or the field is nil.
+func (p *Property) GetOwnership() *Ownership {
+	if p == nil || p.Ownership == nil {
+		return &Ownership{}
+	}
+	return p.Ownership
+}
+
+// GetTax returns the Tax field, or an empty Tax if Property or the field is nil.
+func (p *Property) GetTax() *Tax {
+	if p == nil || p.Tax == nil {
+		return &Tax{}
+	}
+	return p.Tax
+}
+
+// GetSchools returns the Schools field, or the zero value if Property is nil.
+func (p *Property) GetSchools() []School {
+	if p == nil {
+		return nil
+	}
+	return p.Schools
+}
+
+// GetStatus returns the Status field, or an empty Status if IDResponse or the field is nil.
+func (i *IDResponse) GetStatus() *Status {
+	if i == nil || i.Status == nil {
+		return &Status{}
+	}
+	return i.Status
+}
+
+// GetIdentifier returns the Identifier field, or the zero value if IDResponse is nil.
+func (i *IDResponse) GetIdentifier() []*Identifier {
+	if i == nil {
+		return nil
+	}
+	return i.Identifier
+}
+
+// GetStatus returns the Status field, or an empty Status if DetailResponse or the field is nil.
+func (d *DetailResponse) GetStatus() *Status {
+	if d == nil || d.Status == nil {
+		return &Status{}
+	}
+	return d.Status
+}
+
+// GetProperty returns the Property field, or the zero value if DetailResponse is nil.
+func (d *DetailResponse) GetProperty() []*Property {
+	if d == nil {
+		return nil
+	}
+	return d.Property
+}
+
+// GetStatus returns the Status field, or an empty Status if AddressResponse or the field is nil.
+func (a *AddressResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetProperty returns the Property field, or the zero value if AddressResponse is nil.
+func (a *AddressResponse) GetProperty() []*Property {
+	if a == nil {
+		return nil
+	}
+	return a.Property
+}
+
+// GetStatus returns the Status field, or an empty Status if SnapshotResponse or the field is nil.
+func (s *SnapshotResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetProperty returns the Property field, or the zero value if SnapshotResponse is nil.
+func (s *SnapshotResponse) GetProperty() []*Property {
+	if s == nil {
+		return nil
+	}
+	return s.Property
+}
+
+// GetStatus returns the Status field, or an empty Status if ProfileResponse or the field is nil.
+func (p *ProfileResponse) GetStatus() *Status {
+	if p == nil || p.Status == nil {
+		return &Status{}
+	}
+	return p.Status
+}
+
+// GetProperty returns the Property field, or the zero value if ProfileResponse is nil.
+func (p *ProfileResponse) GetProperty() []*Property {
+	if p == nil {
+		return nil
+	}
+	return p.Property
+}
+
+// GetProfile returns the Profile field, or an empty ProfileResponse if ExpandedProfileWithSchools or the field is nil.
+func (e *ExpandedProfileWithSchools) GetProfile() *ProfileResponse {
+	if e == nil || e.Profile == nil {
+		return &ProfileResponse{}
+	}
+	return e.Profile
+}
+
+// GetSchools returns the Schools field, or the zero value if ExpandedProfileWithSchools is nil.
+func (e *ExpandedProfileWithSchools) GetSchools() []*School {
+	if e == nil {
+		return nil
+	}
+	return e.Schools
+}
+
+// GetStatus returns the Status field, or an empty Status if WithSchoolsResponse or the field is nil.
+func (w *WithSchoolsResponse) GetStatus() *Status {
+	if w == nil || w.Status == nil {
+		return &Status{}
+	}
+	return w.Status
+}
+
+// GetProperty returns the Property field, or the zero value if WithSchoolsResponse is nil.
+func (w *WithSchoolsResponse) GetProperty() []*Property {
+	if w == nil {
+		return nil
+	}
+	return w.Property
+}
+
+// GetSchools returns the Schools field, or the zero value if WithSchoolsResponse is nil.
+func (w *WithSchoolsResponse) GetSchools() []*School {
+	if w == nil {
+		return nil
+	}
+	return w.Schools
+}
+
+// GetStatus returns the Status field, or an empty Status if MortgageResponse or the field is nil.
+func (m *MortgageResponse) GetStatus() *Status {
+	if m == nil || m.Status == nil {
+		return &Status{}
+	}
+	return m.Status
+}
+
+// GetProperty returns the Property field, or the zero value if MortgageResponse is nil.
+func (m *MortgageResponse) GetProperty() []*Property {
+	if m == nil {
+		return nil
+	}
+	return m.Property
+}
+
+// GetMortgage returns the Mortgage field, or the zero value if MortgageResponse is nil.
+func (m *MortgageResponse) GetMortgage() []*Mortgage {
+	if m == nil {
+		return nil
+	}
+	return m.Mortgage
+}
+
+// GetMortgageHistory returns the MortgageHistory field, or the zero value if MortgageResponse is nil.
+func (m *MortgageResponse) GetMortgageHistory() []*Mortgage {
+	if m == nil {
+		return nil
+	}
+	return m.MortgageHistory
+}
+
+// GetStatus returns the Status field, or an empty Status if OwnerResponse or the field is nil.
+func (o *OwnerResponse) GetStatus() *Status {
+	if o == nil || o.Status == nil {
+		return &Status{}
+	}
+	return o.Status
+}
+
+// GetProperty returns the Property field, or the zero value if OwnerResponse is nil.
+func (o *OwnerResponse) GetProperty() []*Property {
+	if o == nil {
+		return nil
+	}
+	return o.Property
+}
+
+// GetOwners returns the Owners field, or the zero value if OwnerResponse is nil.
+func (o *OwnerResponse) GetOwners() []*Ownership {
+	if o == nil {
+		return nil
+	}
+	return o.Owners
+}
+
+// GetStatus returns the Status field, or an empty Status if MortgageOwnerResponse or the field is nil.
+func (m *MortgageOwnerResponse) GetStatus() *Status {
+	if m == nil || m.Status == nil {
+		return &Status{}
+	}
+	return m.Status
+}
+
+// GetProperty returns the Property field, or the zero value if MortgageOwnerResponse is nil.
+func (m *MortgageOwnerResponse) GetProperty() []*Property {
+	if m == nil {
+		return nil
+	}
+	return m.Property
+}
+
+// GetMortgage returns the Mortgage field, or the zero value if MortgageOwnerResponse is nil.
+func (m *MortgageOwnerResponse) GetMortgage() []*Mortgage {
+	if m == nil {
+		return nil
+	}
+	return m.Mortgage
+}
+
+// GetOwners returns the Owners field, or the zero value if MortgageOwnerResponse is nil.
+func (m *MortgageOwnerResponse) GetOwners() []*Ownership {
+	if m == nil {
+		return nil
+	}
+	return m.Owners
+}
+
+// GetStatus returns the Status field, or an empty Status if BuildingPermitsResponse or the field is nil.
+func (b *BuildingPermitsResponse) GetStatus() *Status {
+	if b == nil || b.Status == nil {
+		return &Status{}
+	}
+	return b.Status
+}
+
+// GetPermits returns the Permits field, or the zero value if BuildingPermitsResponse is nil.
+func (b *BuildingPermitsResponse) GetPermits() []*BuildingPermit {
+	if b == nil {
+		return nil
+	}
+	return b.Permits
+}
+
+// GetStatus returns the Status field, or an empty Status if SaleDetailResponse or the field is nil.
+func (s *SaleDetailResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSale returns the Sale field, or the zero value if SaleDetailResponse is nil.
+func (s *SaleDetailResponse) GetSale() []*Sale {
+	if s == nil {
+		return nil
+	}
+	return s.Sale
+}
+
+// GetStatus returns the Status field, or an empty Status if SaleSnapshotResponse or the field is nil.
+func (s *SaleSnapshotResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSale returns the Sale field, or the zero value if SaleSnapshotResponse is nil.
+func (s *SaleSnapshotResponse) GetSale() []*Sale {
+	if s == nil {
+		return nil
+	}
+	return s.Sale
+}
+
+// GetStatus returns the Status field, or an empty Status if AssessmentDetailResponse or the field is nil.
+func (a *AssessmentDetailResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetAssessment returns the Assessment field, or the zero value if AssessmentDetailResponse is nil.
+func (a *AssessmentDetailResponse) GetAssessment() []*Assessment {
+	if a == nil {
+		return nil
+	}
+	return a.Assessment
+}
+
+// GetStatus returns the Status field, or an empty Status if AssessmentSnapshotResponse or the field is nil.
+func (a *AssessmentSnapshotResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetAssessment returns the Assessment field, or the zero value if AssessmentSnapshotResponse is nil.
+func (a *AssessmentSnapshotResponse) GetAssessment() []*Assessment {
+	if a == nil {
+		return nil
+	}
+	return a.Assessment
+}
+
+// GetStatus returns the Status field, or an empty Status if AssessmentHistoryResponse or the field is nil.
+func (a *AssessmentHistoryResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetHistory returns the History field, or the zero value if AssessmentHistoryResponse is nil.
+func (a *AssessmentHistoryResponse) GetHistory() []*AssessmentHistoryRecord {
+	if a == nil {
+		return nil
+	}
+	return a.History
+}
+
+// GetStatus returns the Status field, or an empty Status if AVMSnapshotResponse or the field is nil.
+func (a *AVMSnapshotResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetAVM returns the AVM field, or the zero value if AVMSnapshotResponse is nil.
+func (a *AVMSnapshotResponse) GetAVM() []*AVM {
+	if a == nil {
+		return nil
+	}
+	return a.AVM
+}
+
+// GetStatus returns the Status field, or an empty Status if AttomAVMDetailResponse or the field is nil.
+func (a *AttomAVMDetailResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetAVM returns the AVM field, or the zero value if AttomAVMDetailResponse is nil.
+func (a *AttomAVMDetailResponse) GetAVM() []*AVM {
+	if a == nil {
+		return nil
+	}
+	return a.AVM
+}
+
+// GetStatus returns the Status field, or an empty Status if AVMHistoryResponse or the field is nil.
+func (a *AVMHistoryResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetHistory returns the History field, or the zero value if AVMHistoryResponse is nil.
+func (a *AVMHistoryResponse) GetHistory() []*AVMHistoryRecord {
+	if a == nil {
+		return nil
+	}
+	return a.History
+}
+
+// GetStatus returns the Status field, or an empty Status if RentalAVMResponse or the field is nil.
+func (r *RentalAVMResponse) GetStatus() *Status {
+	if r == nil || r.Status == nil {
+		return &Status{}
+	}
+	return r.Status
+}
+
+// GetRental returns the Rental field, or the zero value if RentalAVMResponse is nil.
+func (r *RentalAVMResponse) GetRental() []*RentalAVM {
+	if r == nil {
+		return nil
+	}
+	return r.Rental
+}
+
+// GetStatus returns the Status field, or an empty Status if SalesHistoryResponse or the field is nil.
+func (s *SalesHistoryResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSales returns the Sales field, or the zero value if SalesHistoryResponse is nil.
+func (s *SalesHistoryResponse) GetSales() []*SalesHistoryRecord {
+	if s == nil {
+		return nil
+	}
+	return s.Sales
+}
+
+// GetStatus returns the Status field, or an empty Status if ExpandedSalesHistoryResponse or the field is nil.
+func (e *ExpandedSalesHistoryResponse) GetStatus() *Status {
+	if e == nil || e.Status == nil {
+		return &Status{}
+	}
+	return e.Status
+}
+
+// GetSales returns the Sales field, or the zero value if ExpandedSalesHistoryResponse is nil.
+func (e *ExpandedSalesHistoryResponse) GetSales() []*ExpandedSalesHistoryRecord {
+	if e == nil {
+		return nil
+	}
+	return e.Sales
+}
+
+// GetStatus returns the Status field, or an empty Status if SalesTrendSnapshotResponse or the field is nil.
+func (s *SalesTrendSnapshotResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetTrends returns the Trends field, or the zero value if SalesTrendSnapshotResponse is nil.
+func (s *SalesTrendSnapshotResponse) GetTrends() []*SalesTrendRecord {
+	if s == nil {
+		return nil
+	}
+	return s.Trends
+}
+
+// GetStatus returns the Status field, or an empty Status if TransactionSalesTrendResponse or the field is nil.
+func (t *TransactionSalesTrendResponse) GetStatus() *Status {
+	if t == nil || t.Status == nil {
+		return &Status{}
+	}
+	return t.Status
+}
+
+// GetTrends returns the Trends field, or the zero value if TransactionSalesTrendResponse is nil.
+func (t *TransactionSalesTrendResponse) GetTrends() []*SalesTrendRecord {
+	if t == nil {
+		return nil
+	}
+	return t.Trends
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolSearchResponse or the field is nil.
+func (s *SchoolSearchResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSchool returns the School field, or the zero value if SchoolSearchResponse is nil.
+func (s *SchoolSearchResponse) GetSchool() []*School {
+	if s == nil {
+		return nil
+	}
+	return s.School
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolProfileResponse or the field is nil.
+func (s *SchoolProfileResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSchool returns the School field, or the zero value if SchoolProfileResponse is nil.
+func (s *SchoolProfileResponse) GetSchool() []*School {
+	if s == nil {
+		return nil
+	}
+	return s.School
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolDistrictResponse or the field is nil.
+func (s *SchoolDistrictResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetDistrict returns the District field, or the zero value if SchoolDistrictResponse is nil.
+func (s *SchoolDistrictResponse) GetDistrict() []*SchoolDistrict {
+	if s == nil {
+		return nil
+	}
+	return s.District
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolDetailWithSchoolsResponse or the field is nil.
+func (s *SchoolDetailWithSchoolsResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetProperty returns the Property field, or the zero value if SchoolDetailWithSchoolsResponse is nil.
+func (s *SchoolDetailWithSchoolsResponse) GetProperty() []*Property {
+	if s == nil {
+		return nil
+	}
+	return s.Property
+}
+
+// GetSchools returns the Schools field, or the zero value if SchoolDetailWithSchoolsResponse is nil.
+func (s *SchoolDetailWithSchoolsResponse) GetSchools() []*School {
+	if s == nil {
+		return nil
+	}
+	return s.Schools
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolSnapshotResponse or the field is nil.
+func (s *SchoolSnapshotResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSchool returns the School field, or the zero value if SchoolSnapshotResponse is nil.
+func (s *SchoolSnapshotResponse) GetSchool() []*School {
+	if s == nil {
+		return nil
+	}
+	return s.School
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolDetailResponse or the field is nil.
+func (s *SchoolDetailResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSchool returns the School field, or the zero value if SchoolDetailResponse is nil.
+func (s *SchoolDetailResponse) GetSchool() []*School {
+	if s == nil {
+		return nil
+	}
+	return s.School
+}
+
+// GetStatus returns the Status field, or an empty Status if SchoolDistrictDetailResponse or the field is nil.
+func (s *SchoolDistrictDetailResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetDistrict returns the District field, or the zero value if SchoolDistrictDetailResponse is nil.
+func (s *SchoolDistrictDetailResponse) GetDistrict() []*SchoolDistrict {
+	if s == nil {
+		return nil
+	}
+	return s.District
+}
+
+// GetHomeEquity returns the HomeEquity field, or the zero value if HomeEquityResponse or the field is nil.
+func (h *HomeEquityResponse) GetHomeEquity() float64 {
+	if h == nil || h.HomeEquity == nil {
+		return 0
+	}
+	return *h.HomeEquity
+}
+
+// GetStatus returns the Status field, or an empty Status if HomeEquityResponse or the field is nil.
+func (h *HomeEquityResponse) GetStatus() *Status {
+	if h == nil || h.Status == nil {
+		return &Status{}
+	}
+	return h.Status
+}
+
+// GetProperty returns the Property field, or the zero value if HomeEquityResponse is nil.
+func (h *HomeEquityResponse) GetProperty() []*Property {
+	if h == nil {
+		return nil
+	}
+	return h.Property
+}
+
+// GetStatus returns the Status field, or an empty Status if AVMSnapshotGeoResponse or the field is nil.
+func (a *AVMSnapshotGeoResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetAVM returns the AVM field, or the zero value if AVMSnapshotGeoResponse is nil.
+func (a *AVMSnapshotGeoResponse) GetAVM() []*AVM {
+	if a == nil {
+		return nil
+	}
+	return a.AVM
+}
+
+// GetStatus returns the Status field, or an empty Status if AllEventsDetailResponse or the field is nil.
+func (a *AllEventsDetailResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetEvents returns the Events field, or the zero value if AllEventsDetailResponse is nil.
+func (a *AllEventsDetailResponse) GetEvents() []*AllEventsRecord {
+	if a == nil {
+		return nil
+	}
+	return a.Events
+}
+
+// GetStatus returns the Status field, or an empty Status if AllEventsSnapshotResponse or the field is nil.
+func (a *AllEventsSnapshotResponse) GetStatus() *Status {
+	if a == nil || a.Status == nil {
+		return &Status{}
+	}
+	return a.Status
+}
+
+// GetSnapshot returns the Snapshot field, or the zero value if AllEventsSnapshotResponse is nil.
+func (a *AllEventsSnapshotResponse) GetSnapshot() []*AllEventsSnapshot {
+	if a == nil {
+		return nil
+	}
+	return a.Snapshot
+}
+
+// GetPropertyID returns the PropertyID field, or the zero value if AllEventsSnapshot or the field is nil.
+func (a *AllEventsSnapshot) GetPropertyID() string {
+	if a == nil || a.PropertyID == nil {
+		return ""
+	}
+	return *a.PropertyID
+}
+
+// GetAddress returns the Address field, or the zero value if AllEventsSnapshot or the field is nil.
+func (a *AllEventsSnapshot) GetAddress() string {
+	if a == nil || a.Address == nil {
+		return ""
+	}
+	return *a.Address
+}
+
+// GetEventCount returns the EventCount field, or the zero value if AllEventsSnapshot or the field is nil.
+func (a *AllEventsSnapshot) GetEventCount() int {
+	if a == nil || a.EventCount == nil {
+		return 0
+	}
+	return *a.EventCount
+}
+
+// GetLastEvent returns the LastEvent field, or the zero value if AllEventsSnapshot or the field is nil.
+func (a *AllEventsSnapshot) GetLastEvent() string {
+	if a == nil || a.LastEvent == nil {
+		return ""
+	}
+	return *a.LastEvent
+}
+
+// GetField returns the Field field, or the zero value if EnumerationsDetail or the field is nil.
+func (e *EnumerationsDetail) GetField() string {
+	if e == nil || e.Field == nil {
+		return ""
+	}
+	return *e.Field
+}
+
+// GetValue returns the Value field, or the zero value if EnumerationsDetail or the field is nil.
+func (e *EnumerationsDetail) GetValue() string {
+	if e == nil || e.Value == nil {
+		return ""
+	}
+	return *e.Value
+}
+
+// GetStatus returns the Status field, or an empty Status if EnumerationsDetailResponse or the field is nil.
+func (e *EnumerationsDetailResponse) GetStatus() *Status {
+	if e == nil || e.Status == nil {
+		return &Status{}
+	}
+	return e.Status
+}
+
+// GetEnumerations returns the Enumerations field, or the zero value if EnumerationsDetailResponse is nil.
+func (e *EnumerationsDetailResponse) GetEnumerations() []*EnumerationsDetail {
+	if e == nil {
+		return nil
+	}
+	return e.Enumerations
+}
+
+// GetStatus returns the Status field, or an empty Status if BoundaryResponse or the field is nil.
+func (b *BoundaryResponse) GetStatus() *Status {
+	if b == nil || b.Status == nil {
+		return &Status{}
+	}
+	return b.Status
+}
+
+// GetBoundary returns the Boundary field, or an empty Boundary if BoundaryResponse or the field is nil.
+func (b *BoundaryResponse) GetBoundary() *Boundary {
+	if b == nil || b.Boundary == nil {
+		return &Boundary{}
+	}
+	return b.Boundary
+}
+
+// GetGeoID returns the GeoID field, or the zero value if Boundary or the field is nil.
+func (b *Boundary) GetGeoID() string {
+	if b == nil || b.GeoID == nil {
+		return ""
+	}
+	return *b.GeoID
+}
+
+// GetName returns the Name field, or the zero value if Boundary or the field is nil.
+func (b *Boundary) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetType returns the Type field, or the zero value if Boundary or the field is nil.
+func (b *Boundary) GetType() string {
+	if b == nil || b.Type == nil {
+		return ""
+	}
+	return *b.Type
+}
+
+// GetGeometry returns the Geometry field, or an empty Geometry if Boundary or the field is nil.
+func (b *Boundary) GetGeometry() *Geometry {
+	if b == nil || b.Geometry == nil {
+		return &Geometry{}
+	}
+	return b.Geometry
+}
+
+// GetType returns the Type field, or the zero value if Geometry or the field is nil.
+func (g *Geometry) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetCoordinates returns the Coordinates field, or the zero value if Geometry is nil.
+func (g *Geometry) GetCoordinates() interface{} {
+	if g == nil {
+		return nil
+	}
+	return g.Coordinates
+}
+
+// GetStatus returns the Status field, or an empty Status if HierarchyResponse or the field is nil.
+func (h *HierarchyResponse) GetStatus() *Status {
+	if h == nil || h.Status == nil {
+		return &Status{}
+	}
+	return h.Status
+}
+
+// GetHierarchy returns the Hierarchy field, or the zero value if HierarchyResponse is nil.
+func (h *HierarchyResponse) GetHierarchy() []*Hierarchy {
+	if h == nil {
+		return nil
+	}
+	return h.Hierarchy
+}
+
+// GetGeoID returns the GeoID field, or the zero value if Hierarchy or the field is nil.
+func (h *Hierarchy) GetGeoID() string {
+	if h == nil || h.GeoID == nil {
+		return ""
+	}
+	return *h.GeoID
+}
+
+// GetName returns the Name field, or the zero value if Hierarchy or the field is nil.
+func (h *Hierarchy) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetType returns the Type field, or the zero value if Hierarchy or the field is nil.
+func (h *Hierarchy) GetType() string {
+	if h == nil || h.Type == nil {
+		return ""
+	}
+	return *h.Type
+}
+
+// GetLevel returns the Level field, or the zero value if Hierarchy or the field is nil.
+func (h *Hierarchy) GetLevel() string {
+	if h == nil || h.Level == nil {
+		return ""
+	}
+	return *h.Level
+}
+
+// GetStatus returns the Status field, or an empty Status if CBSAResponse or the field is nil.
+func (c *CBSAResponse) GetStatus() *Status {
+	if c == nil || c.Status == nil {
+		return &Status{}
+	}
+	return c.Status
+}
+
+// GetCBSA returns the CBSA field, or the zero value if CBSAResponse is nil.
+func (c *CBSAResponse) GetCBSA() []*CBSA {
+	if c == nil {
+		return nil
+	}
+	return c.CBSA
+}
+
+// GetGeoID returns the GeoID field, or the zero value if CBSA or the field is nil.
+func (c *CBSA) GetGeoID() string {
+	if c == nil || c.GeoID == nil {
+		return ""
+	}
+	return *c.GeoID
+}
+
+// GetName returns the Name field, or the zero value if CBSA or the field is nil.
+func (c *CBSA) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetType returns the Type field, or the zero value if CBSA or the field is nil.
+func (c *CBSA) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetStateCode returns the StateCode field, or the zero value if CBSA or the field is nil.
+func (c *CBSA) GetStateCode() string {
+	if c == nil || c.StateCode == nil {
+		return ""
+	}
+	return *c.StateCode
+}
+
+// GetStatus returns the Status field, or an empty Status if CountyResponse or the field is nil.
+func (c *CountyResponse) GetStatus() *Status {
+	if c == nil || c.Status == nil {
+		return &Status{}
+	}
+	return c.Status
+}
+
+// GetCounties returns the Counties field, or the zero value if CountyResponse is nil.
+func (c *CountyResponse) GetCounties() []*County {
+	if c == nil {
+		return nil
+	}
+	return c.Counties
+}
+
+// GetGeoID returns the GeoID field, or the zero value if County or the field is nil.
+func (c *County) GetGeoID() string {
+	if c == nil || c.GeoID == nil {
+		return ""
+	}
+	return *c.GeoID
+}
+
+// GetName returns the Name field, or the zero value if County or the field is nil.
+func (c *County) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetStateCode returns the StateCode field, or the zero value if County or the field is nil.
+func (c *County) GetStateCode() string {
+	if c == nil || c.StateCode == nil {
+		return ""
+	}
+	return *c.StateCode
+}
+
+// GetFIPS returns the FIPS field, or the zero value if County or the field is nil.
+func (c *County) GetFIPS() string {
+	if c == nil || c.FIPS == nil {
+		return ""
+	}
+	return *c.FIPS
+}
+
+// GetStatus returns the Status field, or an empty Status if StateResponse or the field is nil.
+func (s *StateResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetStates returns the States field, or the zero value if StateResponse is nil.
+func (s *StateResponse) GetStates() []*State {
+	if s == nil {
+		return nil
+	}
+	return s.States
+}
+
+// GetGeoID returns the GeoID field, or the zero value if State or the field is nil.
+func (s *State) GetGeoID() string {
+	if s == nil || s.GeoID == nil {
+		return ""
+	}
+	return *s.GeoID
+}
+
+// GetName returns the Name field, or the zero value if State or the field is nil.
+func (s *State) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetCode returns the Code field, or the zero value if State or the field is nil.
+func (s *State) GetCode() string {
+	if s == nil || s.Code == nil {
+		return ""
+	}
+	return *s.Code
+}
+
+// GetStatus returns the Status field, or an empty Status if GeoidResponse or the field is nil.
+func (g *GeoidResponse) GetStatus() *Status {
+	if g == nil || g.Status == nil {
+		return &Status{}
+	}
+	return g.Status
+}
+
+// GetGeoids returns the Geoids field, or the zero value if GeoidResponse is nil.
+func (g *GeoidResponse) GetGeoids() []*Geoid {
+	if g == nil {
+		return nil
+	}
+	return g.Geoids
+}
+
+// GetID returns the ID field, or the zero value if Geoid or the field is nil.
+func (g *Geoid) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetName returns the Name field, or the zero value if Geoid or the field is nil.
+func (g *Geoid) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetType returns the Type field, or the zero value if Geoid or the field is nil.
+func (g *Geoid) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetLevel returns the Level field, or the zero value if Geoid or the field is nil.
+func (g *Geoid) GetLevel() string {
+	if g == nil || g.Level == nil {
+		return ""
+	}
+	return *g.Level
+}
+
+// GetStatus returns the Status field, or an empty Status if LegacyGeoidResponse or the field is nil.
+func (l *LegacyGeoidResponse) GetStatus() *Status {
+	if l == nil || l.Status == nil {
+		return &Status{}
+	}
+	return l.Status
+}
+
+// GetLegacyGeoids returns the LegacyGeoids field, or the zero value if LegacyGeoidResponse is nil.
+func (l *LegacyGeoidResponse) GetLegacyGeoids() []*LegacyGeoid {
+	if l == nil {
+		return nil
+	}
+	return l.LegacyGeoids
+}
+
+// GetID returns the ID field, or the zero value if LegacyGeoid or the field is nil.
+func (l *LegacyGeoid) GetID() string {
+	if l == nil || l.ID == nil {
+		return ""
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field, or the zero value if LegacyGeoid or the field is nil.
+func (l *LegacyGeoid) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetType returns the Type field, or the zero value if LegacyGeoid or the field is nil.
+func (l *LegacyGeoid) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// GetStatus returns the Status field, or an empty Status if POIResponse or the field is nil.
+func (p *POIResponse) GetStatus() *Status {
+	if p == nil || p.Status == nil {
+		return &Status{}
+	}
+	return p.Status
+}
+
+// GetPOIs returns the POIs field, or the zero value if POIResponse is nil.
+func (p *POIResponse) GetPOIs() []*POI {
+	if p == nil {
+		return nil
+	}
+	return p.POIs
+}
+
+// GetID returns the ID field, or the zero value if POI or the field is nil.
+func (p *POI) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field, or the zero value if POI or the field is nil.
+func (p *POI) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetCategory returns the Category field, or the zero value if POI or the field is nil.
+func (p *POI) GetCategory() string {
+	if p == nil || p.Category == nil {
+		return ""
+	}
+	return *p.Category
+}
+
+// GetAddress returns the Address field, or an empty Address if POI or the field is nil.
+func (p *POI) GetAddress() *Address {
+	if p == nil || p.Address == nil {
+		return &Address{}
+	}
+	return p.Address
+}
+
+// GetGeoLocation returns the GeoLocation field, or an empty GeoLocation if POI or the field is nil.
+func (p *POI) GetGeoLocation() *GeoLocation {
+	if p == nil || p.GeoLocation == nil {
+		return &GeoLocation{}
+	}
+	return p.GeoLocation
+}
+
+// GetDistance returns the Distance field, or the zero value if POI or the field is nil.
+func (p *POI) GetDistance() float64 {
+	if p == nil || p.Distance == nil {
+		return 0
+	}
+	return *p.Distance
+}
+
+// GetStatus returns the Status field, or an empty Status if POICategoryResponse or the field is nil.
+func (p *POICategoryResponse) GetStatus() *Status {
+	if p == nil || p.Status == nil {
+		return &Status{}
+	}
+	return p.Status
+}
+
+// GetCategories returns the Categories field, or the zero value if POICategoryResponse is nil.
+func (p *POICategoryResponse) GetCategories() []*POICategory {
+	if p == nil {
+		return nil
+	}
+	return p.Categories
+}
+
+// GetID returns the ID field, or the zero value if POICategory or the field is nil.
+func (p *POICategory) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field, or the zero value if POICategory or the field is nil.
+func (p *POICategory) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetDescription returns the Description field, or the zero value if POICategory or the field is nil.
+func (p *POICategory) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetStatus returns the Status field, or an empty Status if CommunityResponse or the field is nil.
+func (c *CommunityResponse) GetStatus() *Status {
+	if c == nil || c.Status == nil {
+		return &Status{}
+	}
+	return c.Status
+}
+
+// GetCommunities returns the Communities field, or the zero value if CommunityResponse is nil.
+func (c *CommunityResponse) GetCommunities() []*Community {
+	if c == nil {
+		return nil
+	}
+	return c.Communities
+}
+
+// GetID returns the ID field, or the zero value if Community or the field is nil.
+func (c *Community) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetName returns the Name field, or the zero value if Community or the field is nil.
+func (c *Community) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetType returns the Type field, or the zero value if Community or the field is nil.
+func (c *Community) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetDescription returns the Description field, or the zero value if Community or the field is nil.
+func (c *Community) GetDescription() string {
+	if c == nil || c.Description == nil {
+		return ""
+	}
+	return *c.Description
+}
+
+// GetGeoLocation returns the GeoLocation field, or an empty GeoLocation if Community or the field is nil.
+func (c *Community) GetGeoLocation() *GeoLocation {
+	if c == nil || c.GeoLocation == nil {
+		return &GeoLocation{}
+	}
+	return c.GeoLocation
+}
+
+// GetBoundary returns the Boundary field, or an empty Boundary if Community or the field is nil.
+func (c *Community) GetBoundary() *Boundary {
+	if c == nil || c.Boundary == nil {
+		return &Boundary{}
+	}
+	return c.Boundary
+}
+
+// GetScores returns the Scores field, or an empty CommunityScores if Community or the field is nil.
+func (c *Community) GetScores() *CommunityScores {
+	if c == nil || c.Scores == nil {
+		return &CommunityScores{}
+	}
+	return c.Scores
+}
+
+// GetCrime returns the Crime field, or an empty CrimeScores if CommunityScores or the field is nil.
+func (c *CommunityScores) GetCrime() *CrimeScores {
+	if c == nil || c.Crime == nil {
+		return &CrimeScores{}
+	}
+	return c.Crime
+}
+
+// GetAffordability returns the Affordability field, or an empty AffordabilityScores if CommunityScores or the field is nil.
+func (c *CommunityScores) GetAffordability() *AffordabilityScores {
+	if c == nil || c.Affordability == nil {
+		return &AffordabilityScores{}
+	}
+	return c.Affordability
+}
+
+// GetCommute returns the Commute field, or an empty CommuteScores if CommunityScores or the field is nil.
+func (c *CommunityScores) GetCommute() *CommuteScores {
+	if c == nil || c.Commute == nil {
+		return &CommuteScores{}
+	}
+	return c.Commute
+}
+
+// GetAirQuality returns the AirQuality field, or an empty AirQualityScores if CommunityScores or the field is nil.
+func (c *CommunityScores) GetAirQuality() *AirQualityScores {
+	if c == nil || c.AirQuality == nil {
+		return &AirQualityScores{}
+	}
+	return c.AirQuality
+}
+
+// GetNaturalDisasters returns the NaturalDisasters field, or an empty NaturalDisasterScores if CommunityScores or the field is nil.
+func (c *CommunityScores) GetNaturalDisasters() *NaturalDisasterScores {
+	if c == nil || c.NaturalDisasters == nil {
+		return &NaturalDisasterScores{}
+	}
+	return c.NaturalDisasters
+}
+
+// GetCrimeIndex returns the CrimeIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetCrimeIndex() float64 {
+	if c == nil || c.CrimeIndex == nil {
+		return 0
+	}
+	return *c.CrimeIndex
+}
+
+// GetMurderIndex returns the MurderIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetMurderIndex() float64 {
+	if c == nil || c.MurderIndex == nil {
+		return 0
+	}
+	return *c.MurderIndex
+}
+
+// GetForcibleRapeIndex returns the ForcibleRapeIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetForcibleRapeIndex() float64 {
+	if c == nil || c.ForcibleRapeIndex == nil {
+		return 0
+	}
+	return *c.ForcibleRapeIndex
+}
+
+// GetForcibleRobberyIndex returns the ForcibleRobberyIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetForcibleRobberyIndex() float64 {
+	if c == nil || c.ForcibleRobberyIndex == nil {
+		return 0
+	}
+	return *c.ForcibleRobberyIndex
+}
+
+// GetAggravatedAssaultIndex returns the AggravatedAssaultIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetAggravatedAssaultIndex() float64 {
+	if c == nil || c.AggravatedAssaultIndex == nil {
+		return 0
+	}
+	return *c.AggravatedAssaultIndex
+}
+
+// GetBurglaryIndex returns the BurglaryIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetBurglaryIndex() float64 {
+	if c == nil || c.BurglaryIndex == nil {
+		return 0
+	}
+	return *c.BurglaryIndex
+}
+
+// GetLarcenyIndex returns the LarcenyIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetLarcenyIndex() float64 {
+	if c == nil || c.LarcenyIndex == nil {
+		return 0
+	}
+	return *c.LarcenyIndex
+}
+
+// GetMotorVehicleTheftIndex returns the MotorVehicleTheftIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetMotorVehicleTheftIndex() float64 {
+	if c == nil || c.MotorVehicleTheftIndex == nil {
+		return 0
+	}
+	return *c.MotorVehicleTheftIndex
+}
+
+// GetMortalityIndex returns the MortalityIndex field, or the zero value if CrimeScores or the field is nil.
+func (c *CrimeScores) GetMortalityIndex() float64 {
+	if c == nil || c.MortalityIndex == nil {
+		return 0
+	}
+	return *c.MortalityIndex
+}
+
+// GetAirPollutionIndex returns the AirPollutionIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetAirPollutionIndex() float64 {
+	if a == nil || a.AirPollutionIndex == nil {
+		return 0
+	}
+	return *a.AirPollutionIndex
+}
+
+// GetOzoneIndex returns the OzoneIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetOzoneIndex() float64 {
+	if a == nil || a.OzoneIndex == nil {
+		return 0
+	}
+	return *a.OzoneIndex
+}
+
+// GetLeadIndex returns the LeadIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetLeadIndex() float64 {
+	if a == nil || a.LeadIndex == nil {
+		return 0
+	}
+	return *a.LeadIndex
+}
+
+// GetCarbonMonoxideIndex returns the CarbonMonoxideIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetCarbonMonoxideIndex() float64 {
+	if a == nil || a.CarbonMonoxideIndex == nil {
+		return 0
+	}
+	return *a.CarbonMonoxideIndex
+}
+
+// GetNitrogenDioxideIndex returns the NitrogenDioxideIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetNitrogenDioxideIndex() float64 {
+	if a == nil || a.NitrogenDioxideIndex == nil {
+		return 0
+	}
+	return *a.NitrogenDioxideIndex
+}
+
+// GetParticulateMatterIndex returns the ParticulateMatterIndex field, or the zero value if AirQualityScores or the field is nil.
+func (a *AirQualityScores) GetParticulateMatterIndex() float64 {
+	if a == nil || a.ParticulateMatterIndex == nil {
+		return 0
+	}
+	return *a.ParticulateMatterIndex
+}
+
+// GetWeatherIndex returns the WeatherIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetWeatherIndex() float64 {
+	if n == nil || n.WeatherIndex == nil {
+		return 0
+	}
+	return *n.WeatherIndex
+}
+
+// GetEarthquakeIndex returns the EarthquakeIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetEarthquakeIndex() float64 {
+	if n == nil || n.EarthquakeIndex == nil {
+		return 0
+	}
+	return *n.EarthquakeIndex
+}
+
+// GetHailIndex returns the HailIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetHailIndex() float64 {
+	if n == nil || n.HailIndex == nil {
+		return 0
+	}
+	return *n.HailIndex
+}
+
+// GetHurricaneIndex returns the HurricaneIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetHurricaneIndex() float64 {
+	if n == nil || n.HurricaneIndex == nil {
+		return 0
+	}
+	return *n.HurricaneIndex
+}
+
+// GetTornadoIndex returns the TornadoIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetTornadoIndex() float64 {
+	if n == nil || n.TornadoIndex == nil {
+		return 0
+	}
+	return *n.TornadoIndex
+}
+
+// GetWindIndex returns the WindIndex field, or the zero value if NaturalDisasterScores or the field is nil.
+func (n *NaturalDisasterScores) GetWindIndex() float64 {
+	if n == nil || n.WindIndex == nil {
+		return 0
+	}
+	return *n.WindIndex
+}
+
+// GetAffordabilityIndex returns the AffordabilityIndex field, or the zero value if AffordabilityScores or the field is nil.
+func (a *AffordabilityScores) GetAffordabilityIndex() float64 {
+	if a == nil || a.AffordabilityIndex == nil {
+		return 0
+	}
+	return *a.AffordabilityIndex
+}
+
+// GetMedianHouseholdIncome returns the MedianHouseholdIncome field, or the zero value if AffordabilityScores or the field is nil.
+func (a *AffordabilityScores) GetMedianHouseholdIncome() float64 {
+	if a == nil || a.MedianHouseholdIncome == nil {
+		return 0
+	}
+	return *a.MedianHouseholdIncome
+}
+
+// GetMedianHomeValue returns the MedianHomeValue field, or the zero value if AffordabilityScores or the field is nil.
+func (a *AffordabilityScores) GetMedianHomeValue() float64 {
+	if a == nil || a.MedianHomeValue == nil {
+		return 0
+	}
+	return *a.MedianHomeValue
+}
+
+// GetMedianGrossRent returns the MedianGrossRent field, or the zero value if AffordabilityScores or the field is nil.
+func (a *AffordabilityScores) GetMedianGrossRent() float64 {
+	if a == nil || a.MedianGrossRent == nil {
+		return 0
+	}
+	return *a.MedianGrossRent
+}
+
+// GetMedianTravelTimeToWorkMinutes returns the MedianTravelTimeToWorkMinutes field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetMedianTravelTimeToWorkMinutes() float64 {
+	if c == nil || c.MedianTravelTimeToWorkMinutes == nil {
+		return 0
+	}
+	return *c.MedianTravelTimeToWorkMinutes
+}
+
+// GetTravelTime0To14Pct returns the TravelTime0To14Pct field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetTravelTime0To14Pct() float64 {
+	if c == nil || c.TravelTime0To14Pct == nil {
+		return 0
+	}
+	return *c.TravelTime0To14Pct
+}
+
+// GetTravelTime15To29Pct returns the TravelTime15To29Pct field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetTravelTime15To29Pct() float64 {
+	if c == nil || c.TravelTime15To29Pct == nil {
+		return 0
+	}
+	return *c.TravelTime15To29Pct
+}
+
+// GetTravelTime30To59Pct returns the TravelTime30To59Pct field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetTravelTime30To59Pct() float64 {
+	if c == nil || c.TravelTime30To59Pct == nil {
+		return 0
+	}
+	return *c.TravelTime30To59Pct
+}
+
+// GetTravelTime60To89Pct returns the TravelTime60To89Pct field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetTravelTime60To89Pct() float64 {
+	if c == nil || c.TravelTime60To89Pct == nil {
+		return 0
+	}
+	return *c.TravelTime60To89Pct
+}
+
+// GetTravelTime90PlusPct returns the TravelTime90PlusPct field, or the zero value if CommuteScores or the field is nil.
+func (c *CommuteScores) GetTravelTime90PlusPct() float64 {
+	if c == nil || c.TravelTime90PlusPct == nil {
+		return 0
+	}
+	return *c.TravelTime90PlusPct
+}
+
+// GetStatus returns the Status field, or an empty Status if LocationLookupResponse or the field is nil.
+func (l *LocationLookupResponse) GetStatus() *Status {
+	if l == nil || l.Status == nil {
+		return &Status{}
+	}
+	return l.Status
+}
+
+// GetLocations returns the Locations field, or the zero value if LocationLookupResponse is nil.
+func (l *LocationLookupResponse) GetLocations() []*Location {
+	if l == nil {
+		return nil
+	}
+	return l.Locations
+}
+
+// GetID returns the ID field, or the zero value if Location or the field is nil.
+func (l *Location) GetID() string {
+	if l == nil || l.ID == nil {
+		return ""
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field, or the zero value if Location or the field is nil.
+func (l *Location) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetType returns the Type field, or the zero value if Location or the field is nil.
+func (l *Location) GetType() string {
+	if l == nil || l.Type == nil {
+		return ""
+	}
+	return *l.Type
+}
+
+// GetGeoLocation returns the GeoLocation field, or an empty GeoLocation if Location or the field is nil.
+func (l *Location) GetGeoLocation() *GeoLocation {
+	if l == nil || l.GeoLocation == nil {
+		return &GeoLocation{}
+	}
+	return l.GeoLocation
+}
+
+// GetStatus returns the Status field, or an empty Status if SaleComparablesResponse or the field is nil.
+func (s *SaleComparablesResponse) GetStatus() *Status {
+	if s == nil || s.Status == nil {
+		return &Status{}
+	}
+	return s.Status
+}
+
+// GetSaleComparables returns the SaleComparables field, or the zero value if SaleComparablesResponse is nil.
+func (s *SaleComparablesResponse) GetSaleComparables() []*SaleComparable {
+	if s == nil {
+		return nil
+	}
+	return s.SaleComparables
+}
+
+// GetPropertyID returns the PropertyID field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetPropertyID() string {
+	if s == nil || s.PropertyID == nil {
+		return ""
+	}
+	return *s.PropertyID
+}
+
+// GetAddress returns the Address field, or an empty Address if SaleComparable or the field is nil.
+func (s *SaleComparable) GetAddress() *Address {
+	if s == nil || s.Address == nil {
+		return &Address{}
+	}
+	return s.Address
+}
+
+// GetSaleAmount returns the SaleAmount field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetSaleAmount() float64 {
+	if s == nil || s.SaleAmount == nil {
+		return 0
+	}
+	return *s.SaleAmount
+}
+
+// GetSaleDate returns the SaleDate field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetSaleDate() string {
+	if s == nil || s.SaleDate == nil {
+		return ""
+	}
+	return *s.SaleDate
+}
+
+// GetDistance returns the Distance field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetDistance() float64 {
+	if s == nil || s.Distance == nil {
+		return 0
+	}
+	return *s.Distance
+}
+
+// GetMatchCode returns the MatchCode field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetMatchCode() string {
+	if s == nil || s.MatchCode == nil {
+		return ""
+	}
+	return *s.MatchCode
+}
+
+// GetQuality returns the Quality field, or the zero value if SaleComparable or the field is nil.
+func (s *SaleComparable) GetQuality() string {
+	if s == nil || s.Quality == nil {
+		return ""
+	}
+	return *s.Quality
+}
+
+// GetStatus returns the Status field, or an empty Status if TransportationNoiseResponse or the field is nil.
+func (t *TransportationNoiseResponse) GetStatus() *Status {
+	if t == nil || t.Status == nil {
+		return &Status{}
+	}
+	return t.Status
+}
+
+// GetTransportationNoise returns the TransportationNoise field, or the zero value if TransportationNoiseResponse is nil.
+func (t *TransportationNoiseResponse) GetTransportationNoise() []*TransportationNoise {
+	if t == nil {
+		return nil
+	}
+	return t.TransportationNoise
+}
+
+// GetPropertyID returns the PropertyID field, or the zero value if TransportationNoise or the field is nil.
+func (t *TransportationNoise) GetPropertyID() string {
+	if t == nil || t.PropertyID == nil {
+		return ""
+	}
+	return *t.PropertyID
+}
+
+// GetNoiseLevel returns the NoiseLevel field, or the zero value if TransportationNoise or the field is nil.
+func (t *TransportationNoise) GetNoiseLevel() string {
+	if t == nil || t.NoiseLevel == nil {
+		return ""
+	}
+	return *t.NoiseLevel
+}
+
+// GetSource returns the Source field, or the zero value if TransportationNoise or the field is nil.
+func (t *TransportationNoise) GetSource() string {
+	if t == nil || t.Source == nil {
+		return ""
+	}
+	return *t.Source
+}
+
+// GetDistance returns the Distance field, or the zero value if TransportationNoise or the field is nil.
+func (t *TransportationNoise) GetDistance() float64 {
+	if t == nil || t.Distance == nil {
+		return 0
+	}
+	return *t.Distance
+}
+
+// GetStatus returns the Status field, or an empty Status if ParcelTilesResponse or the field is nil.
+func (p *ParcelTilesResponse) GetStatus() *Status {
+	if p == nil || p.Status == nil {
+		return &Status{}
+	}
+	return p.Status
+}
+
+// GetParcelTiles returns the ParcelTiles field, or the zero value if ParcelTilesResponse is nil.
+func (p *ParcelTilesResponse) GetParcelTiles() []*ParcelTile {
+	if p == nil {
+		return nil
+	}
+	return p.ParcelTiles
+}
+
+// GetTileID returns the TileID field, or the zero value if ParcelTile or the field is nil.
+func (p *ParcelTile) GetTileID() string {
+	if p == nil || p.TileID == nil {
+		return ""
+	}
+	return *p.TileID
+}
+
+// GetFormat returns the Format field, or the zero value if ParcelTile or the field is nil.
+func (p *ParcelTile) GetFormat() string {
+	if p == nil || p.Format == nil {
+		return ""
+	}
+	return *p.Format
+}
+
+// GetData returns the Data field, or the zero value if ParcelTile is nil.
+func (p *ParcelTile) GetData() []byte {
+	if p == nil {
+		return nil
+	}
+	return p.Data
+}
+
+// GetData returns the Data field, or the zero value if ParcelTileData is nil.
+func (p *ParcelTileData) GetData() []byte {
+	if p == nil {
+		return nil
+	}
+	return p.Data
+}
+
+// GetContentType returns the ContentType field, or the zero value if ParcelTileData is nil.
+func (p *ParcelTileData) GetContentType() string {
+	if p == nil {
+		return ""
+	}
+	return p.ContentType
+}
+
+// GetETag returns the ETag field, or the zero value if ParcelTileData is nil.
+func (p *ParcelTileData) GetETag() string {
+	if p == nil {
+		return ""
+	}
+	return p.ETag
+}
+
+// GetIdentifier returns the Identifier field, or an empty Identifier if ProfileBundle or the field is nil.
+func (p *ProfileBundle) GetIdentifier() *Identifier {
+	if p == nil || p.Identifier == nil {
+		return &Identifier{}
+	}
+	return p.Identifier
+}
+
+// GetAddress returns the Address field, or an empty Address if ProfileBundle or the field is nil.
+func (p *ProfileBundle) GetAddress() *Address {
+	if p == nil || p.Address == nil {
+		return &Address{}
+	}
+	return p.Address
+}
+
+// GetAssessment returns the Assessment field, or an empty Assessment if ProfileBundle or the field is nil.
+func (p *ProfileBundle) GetAssessment() *Assessment {
+	if p == nil || p.Assessment == nil {
+		return &Assessment{}
+	}
+	return p.Assessment
+}
+
+// GetAVM returns the AVM field, or an empty AVM if ProfileBundle or the field is nil.
+func (p *ProfileBundle) GetAVM() *AVM {
+	if p == nil || p.AVM == nil {
+		return &AVM{}
+	}
+	return p.AVM
+}
+
+// GetSale returns the Sale field, or an empty Sale if ProfileBundle or the field is nil.
+func (p *ProfileBundle) GetSale() *Sale {
+	if p == nil || p.Sale == nil {
+		return &Sale{}
+	}
+	return p.Sale
+}
+
+// GetStatus returns the Status field, or an empty Status if PreforeclosureDetailsResponse or the field is nil.
+func (p *PreforeclosureDetailsResponse) GetStatus() *Status {
+	if p == nil || p.Status == nil {
+		return &Status{}
+	}
+	return p.Status
+}
+
+// GetPreforeclosureDetails returns the PreforeclosureDetails field, or the zero value if PreforeclosureDetailsResponse is nil.
+func (p *PreforeclosureDetailsResponse) GetPreforeclosureDetails() []*PreforeclosureDetail {
+	if p == nil {
+		return nil
+	}
+	return p.PreforeclosureDetails
+}
+
+// GetPropertyID returns the PropertyID field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetPropertyID() string {
+	if p == nil || p.PropertyID == nil {
+		return ""
+	}
+	return *p.PropertyID
+}
+
+// GetForeclosureID returns the ForeclosureID field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetForeclosureID() string {
+	if p == nil || p.ForeclosureID == nil {
+		return ""
+	}
+	return *p.ForeclosureID
+}
+
+// GetForeclosureType returns the ForeclosureType field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetForeclosureType() string {
+	if p == nil || p.ForeclosureType == nil {
+		return ""
+	}
+	return *p.ForeclosureType
+}
+
+// GetStatus returns the Status field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetFilingDate returns the FilingDate field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetFilingDate() string {
+	if p == nil || p.FilingDate == nil {
+		return ""
+	}
+	return *p.FilingDate
+}
+
+// GetRecordingDate returns the RecordingDate field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetRecordingDate() string {
+	if p == nil || p.RecordingDate == nil {
+		return ""
+	}
+	return *p.RecordingDate
+}
+
+// GetDocumentType returns the DocumentType field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetDocumentType() string {
+	if p == nil || p.DocumentType == nil {
+		return ""
+	}
+	return *p.DocumentType
+}
+
+// GetDocumentNumber returns the DocumentNumber field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetDocumentNumber() string {
+	if p == nil || p.DocumentNumber == nil {
+		return ""
+	}
+	return *p.DocumentNumber
+}
+
+// GetCaseNumber returns the CaseNumber field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetCaseNumber() string {
+	if p == nil || p.CaseNumber == nil {
+		return ""
+	}
+	return *p.CaseNumber
+}
+
+// GetAmount returns the Amount field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetAmount() FlexFloat {
+	if p == nil || p.Amount == nil {
+		return 0
+	}
+	return *p.Amount
+}
+
+// GetDefaultAmount returns the DefaultAmount field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetDefaultAmount() FlexFloat {
+	if p == nil || p.DefaultAmount == nil {
+		return 0
+	}
+	return *p.DefaultAmount
+}
+
+// GetDefaultDate returns the DefaultDate field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetDefaultDate() string {
+	if p == nil || p.DefaultDate == nil {
+		return ""
+	}
+	return *p.DefaultDate
+}
+
+// GetOriginalLoanAmount returns the OriginalLoanAmount field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetOriginalLoanAmount() FlexFloat {
+	if p == nil || p.OriginalLoanAmount == nil {
+		return 0
+	}
+	return *p.OriginalLoanAmount
+}
+
+// GetLoanBalance returns the LoanBalance field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetLoanBalance() FlexFloat {
+	if p == nil || p.LoanBalance == nil {
+		return 0
+	}
+	return *p.LoanBalance
+}
+
+// GetLenderName returns the LenderName field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetLenderName() string {
+	if p == nil || p.LenderName == nil {
+		return ""
+	}
+	return *p.LenderName
+}
+
+// GetBorrowerName returns the BorrowerName field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetBorrowerName() string {
+	if p == nil || p.BorrowerName == nil {
+		return ""
+	}
+	return *p.BorrowerName
+}
+
+// GetTrusteeName returns the TrusteeName field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetTrusteeName() string {
+	if p == nil || p.TrusteeName == nil {
+		return ""
+	}
+	return *p.TrusteeName
+}
+
+// GetTrusteeAddress returns the TrusteeAddress field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetTrusteeAddress() string {
+	if p == nil || p.TrusteeAddress == nil {
+		return ""
+	}
+	return *p.TrusteeAddress
+}
+
+// GetTrusteePhone returns the TrusteePhone field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetTrusteePhone() string {
+	if p == nil || p.TrusteePhone == nil {
+		return ""
+	}
+	return *p.TrusteePhone
+}
+
+// GetAuctionDate returns the AuctionDate field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetAuctionDate() string {
+	if p == nil || p.AuctionDate == nil {
+		return ""
+	}
+	return *p.AuctionDate
+}
+
+// GetAuctionTime returns the AuctionTime field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetAuctionTime() string {
+	if p == nil || p.AuctionTime == nil {
+		return ""
+	}
+	return *p.AuctionTime
+}
+
+// GetAuctionLocation returns the AuctionLocation field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetAuctionLocation() string {
+	if p == nil || p.AuctionLocation == nil {
+		return ""
+	}
+	return *p.AuctionLocation
+}
+
+// GetOpeningBid returns the OpeningBid field, or the zero value if PreforeclosureDetail or the field is nil.
+func (p *PreforeclosureDetail) GetOpeningBid() FlexFloat {
+	if p == nil || p.OpeningBid == nil {
+		return 0
+	}
+	return *p.OpeningBid
+}
@@ -0,0 +1,61 @@
+package property
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAccessorsOnNil(t *testing.T) {
+	for _, model := range []any{(*Property)(nil), &Property{}, (*Address)(nil), (*AVM)(nil), (*Rooms)(nil), (*AllEventsRecord)(nil)} {
+		v := reflect.ValueOf(model)
+		typ := v.Type()
+		for i := 0; i < typ.NumMethod(); i++ {
+			method := typ.Method(i)
+			if !strings.HasPrefix(method.Name, "Get") || method.Type.NumIn() != 1 {
+				continue
+			}
+			name := typ.Elem().Name() + "." + method.Name
+			t.Run(name, func(t *testing.T) {
+				out := v.Method(i).Call(nil)[0]
+				if out.Kind() == reflect.Pointer && out.Type().Elem().Kind() == reflect.Struct {
+					if out.IsNil() {
+						t.Errorf("%s returned a nil struct pointer", name)
+					}
+					return
+				}
+				if !out.IsZero() {
+					t.Errorf("%s = %v, want zero value", name, out)
+				}
+			})
+		}
+	}
+}
+
+func TestAccessorsReturnValues(t *testing.T) {
+	attomID, line1, value, baths := "100", "1 Main St", 450000.0, FlexFloat(2.5)
+	p := &Property{
+		Identifier: &Identifier{AttomID: &attomID},
+		Address:    &Address{Line1: &line1},
+		AVM:        &AVM{Value: &value},
+		Building:   &Building{Rooms: &Rooms{BathsTotal: &baths}},
+	}
+	if got := p.GetIdentifier().GetAttomID(); got != attomID {
+		t.Errorf("GetAttomID() = %q", got)
+	}
+	if got := p.GetAddress().GetLine1(); got != line1 {
+		t.Errorf("GetLine1() = %q", got)
+	}
+	if got := p.GetAVM().GetValue(); got != value {
+		t.Errorf("GetValue() = %v", got)
+	}
+	if got := p.GetBuilding().GetRooms().GetBathsTotal(); got != baths {
+		t.Errorf("GetBathsTotal() = %v", got)
+	}
+	if p.GetAddress() != p.Address {
+		t.Error("expected GetAddress to return the existing pointer")
+	}
+	if got := p.GetBuilding().GetArea().GetLivingSquareFeet(); got != 0 {
+		t.Errorf("expected zero for missing nested field, got %d", got)
+	}
+}
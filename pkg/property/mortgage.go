@@ -0,0 +1,10 @@
+package property
+
+// LoanAmountMoney returns the loan amount as Money, rounded to the nearest cent.
+// The boolean is false when the mortgage or its loan amount is missing.
+func (m *Mortgage) LoanAmountMoney() (Money, bool) {
+	if m == nil || m.LoanAmount == nil {
+		return 0, false
+	}
+	return MoneyFromFloat(*m.LoanAmount), true
+}
@@ -21,6 +21,35 @@ func applyOptions(opts []Option) url.Values {
 	return values
 }
 
+// CombineOptions concatenates option groups into a single slice. Options are
+// applied in order and most set their parameter with url.Values.Set, so a later
+// group overrides any parameter an earlier group also sets. Nil and empty groups
+// are skipped.
+func CombineOptions(groups ...[]Option) []Option {
+	n := 0
+	for _, group := range groups {
+		n += len(group)
+	}
+	combined := make([]Option, 0, n)
+	for _, group := range groups {
+		combined = append(combined, group...)
+	}
+	return combined
+}
+
+// WithOptions bundles opts into a single Option that applies them in order, so a
+// reusable set of options can be passed wherever one Option is accepted. Options
+// that follow the bundle override the parameters it sets.
+func WithOptions(opts ...Option) Option {
+	return func(values url.Values) {
+		for _, opt := range opts {
+			if opt != nil {
+				opt(values)
+			}
+		}
+	}
+}
+
 // WithString sets an arbitrary string parameter when the value is not empty.
 func WithString(key, value string) Option {
 	return func(values url.Values) {
@@ -92,7 +121,7 @@ func WithLatitudeLongitude(latitude, longitude float64) Option {
 	}
 }
 
-// WithRadius sets radius parameter expressed in miles.
+// WithRadius sets radius parameter expressed in miles, the unit ATTOM expects.
 func WithRadius(radiusMiles float64) Option {
 	return func(values url.Values) {
 		if radiusMiles <= 0 {
@@ -102,8 +131,32 @@ func WithRadius(radiusMiles float64) Option {
 	}
 }
 
-// WithPostalCode sets the postalCode query parameter.
+// WithRadiusKilometers sets the radius parameter from a distance in kilometers,
+// converting it to the miles ATTOM expects. Non-positive values are ignored.
+func WithRadiusKilometers(km float64) Option {
+	return WithRadius(KilometersToMiles(km))
+}
+
+// WithBoundingBox sets the minLatitude, minLongitude, maxLatitude, and maxLongitude
+// parameters for bounding-box searches. GetPropertySnapshot rejects boxes whose
+// minimums are not strictly less than their maximums.
+func WithBoundingBox(minLat, minLon, maxLat, maxLon float64) Option {
+	return func(values url.Values) {
+		values.Set("minLatitude", strconv.FormatFloat(minLat, 'f', -1, 64))
+		values.Set("minLongitude", strconv.FormatFloat(minLon, 'f', -1, 64))
+		values.Set("maxLatitude", strconv.FormatFloat(maxLat, 'f', -1, 64))
+		values.Set("maxLongitude", strconv.FormatFloat(maxLon, 'f', -1, 64))
+	}
+}
+
+// WithPostalCode sets the postalCode query parameter. ATTOM matches postalCode
+// on the 5-digit ZIP only and documents no ZIP+4 parameter, so recognizable ZIP+4
+// inputs such as "12345-6789" are truncated to "12345" and the extension is not
+// sent. Values that are not a US ZIP are passed through unchanged.
 func WithPostalCode(code string) Option {
+	if zip5, _, err := NormalizePostalCode(code); err == nil {
+		code = zip5
+	}
 	return WithString("postalCode", code)
 }
 
@@ -127,6 +180,35 @@ func WithPropertyType(propertyType string) Option {
 	return WithString("propertytype", propertyType)
 }
 
+// WithPropertyTypes sets the propertytype parameter to several property types,
+// joined with "|" as ATTOM expects for multi-valued filters. Values are trimmed
+// and upper-cased to match the PropertyType constants. If any value fails
+// ValidatePropertyType, or none are given, the option is a no-op so that an
+// unfiltered search is not silently narrowed to a subset of the intended types.
+func WithPropertyTypes(types ...string) Option {
+	return func(values url.Values) {
+		if len(types) == 0 {
+			return
+		}
+		normalized := make([]string, 0, len(types))
+		for _, propertyType := range types {
+			propertyType = strings.ToUpper(strings.TrimSpace(propertyType))
+			if ValidatePropertyType(propertyType) != nil {
+				return
+			}
+			normalized = append(normalized, propertyType)
+		}
+		values.Set("propertytype", strings.Join(normalized, "|"))
+	}
+}
+
+// WithSnapshotPropertyTypeFilter sets the propertytype parameter after trimming
+// and upper-casing the value to match the PropertyType constants. Snapshot
+// methods reject values that fail ValidatePropertyType before sending a request.
+func WithSnapshotPropertyTypeFilter(propertyType string) Option {
+	return WithPropertyType(strings.ToUpper(strings.TrimSpace(propertyType)))
+}
+
 // WithPropertyIndicator sets the propertyIndicator parameter.
 func WithPropertyIndicator(indicator int) Option {
 	return func(values url.Values) {
@@ -149,6 +231,15 @@ func WithBedsRange(minBeds, maxBeds int) Option {
 	}
 }
 
+// WithBeds restricts results to properties with exactly n bedrooms by setting
+// minBeds and maxBeds to n. Non-positive values are ignored.
+func WithBeds(n int) Option {
+	if n <= 0 {
+		return func(url.Values) {}
+	}
+	return WithBedsRange(n, n)
+}
+
 // WithBathsRange sets minimum and maximum baths filters.
 func WithBathsRange(minBaths, maxBaths float64) Option {
 	return func(values url.Values) {
@@ -161,6 +252,15 @@ func WithBathsRange(minBaths, maxBaths float64) Option {
 	}
 }
 
+// WithBaths restricts results to properties with exactly n total bathrooms by
+// setting minBathsTotal and maxBathsTotal to n. Non-positive values are ignored.
+func WithBaths(n float64) Option {
+	if n <= 0 {
+		return func(url.Values) {}
+	}
+	return WithBathsRange(n, n)
+}
+
 // WithSaleAmountRange sets minimum and maximum sale amount filters.
 func WithSaleAmountRange(minAmt, maxAmt float64) Option {
 	return func(values url.Values) {
@@ -173,6 +273,47 @@ func WithSaleAmountRange(minAmt, maxAmt float64) Option {
 	}
 }
 
+// WithTaxAmountRange sets minimum and maximum tax amount filters for assessment
+// and snapshot searches. Zero values are omitted.
+func WithTaxAmountRange(minAmt, maxAmt float64) Option {
+	return func(values url.Values) {
+		if minAmt > 0 {
+			values.Set("minTaxAmt", strconv.FormatFloat(minAmt, 'f', -1, 64))
+		}
+		if maxAmt > 0 {
+			values.Set("maxTaxAmt", strconv.FormatFloat(maxAmt, 'f', -1, 64))
+		}
+	}
+}
+
+// WithAssessedValueRange sets minimum and maximum total assessed value filters
+// for assessment searches such as GetAssessmentSnapshotGeo. Zero values are
+// omitted.
+func WithAssessedValueRange(minValue, maxValue float64) Option {
+	return func(values url.Values) {
+		if minValue > 0 {
+			values.Set("minAssdTtlValue", strconv.FormatFloat(minValue, 'f', -1, 64))
+		}
+		if maxValue > 0 {
+			values.Set("maxAssdTtlValue", strconv.FormatFloat(maxValue, 'f', -1, 64))
+		}
+	}
+}
+
+// WithAVMConfidenceRange sets minimum and maximum AVM confidence score filters,
+// e.g. to drop low-confidence valuations from GetAVMSnapshotGeo. Confidence
+// scores range from 0 to 100; zero, negative, and out-of-range bounds are omitted.
+func WithAVMConfidenceRange(minScore, maxScore float64) Option {
+	return func(values url.Values) {
+		if minScore > 0 && minScore <= 100 {
+			values.Set("minConfidence", strconv.FormatFloat(minScore, 'f', -1, 64))
+		}
+		if maxScore > 0 && maxScore <= 100 {
+			values.Set("maxConfidence", strconv.FormatFloat(maxScore, 'f', -1, 64))
+		}
+	}
+}
+
 // WithUniversalSizeRange filters by the universal size in square feet.
 func WithUniversalSizeRange(minSize, maxSize int) Option {
 	return func(values url.Values) {
@@ -248,6 +389,111 @@ func WithISODateRange(prefix string, start, end time.Time) Option {
 	}
 }
 
+// WithSaleDateRange sets startSaleSearchDate and endSaleSearchDate, the
+// standardized sale date ATTOM uses for searching sale, AVM, and all-events
+// records. Zero times are omitted.
+func WithSaleDateRange(start, end time.Time) Option {
+	return WithDateRange("SaleSearchDate", start, end)
+}
+
+// WithRecordingDateRange sets startSaleTransDate and endSaleTransDate. ATTOM has
+// no filter on the county recording date itself; the sale transaction date is
+// the signature date on the recorded document, which is the same as or precedes
+// the recording date. Zero times are omitted.
+func WithRecordingDateRange(start, end time.Time) Option {
+	return WithDateRange("SaleTransDate", start, end)
+}
+
+// WithAssessmentYearRange limits assessment records to those updated between
+// January 1 of minYear and December 31 of maxYear, using startCalendarDate and
+// endCalendarDate. ATTOM does not accept a tax year filter, so the calendar date
+// of the record is the closest available bound. Non-positive years are omitted.
+func WithAssessmentYearRange(minYear, maxYear int) Option {
+	var start, end time.Time
+	if minYear > 0 {
+		start = time.Date(minYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if maxYear > 0 {
+		end = time.Date(maxYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+	return WithDateRange("CalendarDate", start, end)
+}
+
+// WithMortgageHistory requests historical mortgage records alongside current
+// mortgages on the detailmortgage endpoint. The parameter is omitted when false.
+func WithMortgageHistory(include bool) Option {
+	return func(values url.Values) {
+		if include {
+			values.Set("mortgageHistory", "true")
+		}
+	}
+}
+
+// WithAggregationLevel sets the aggregationLevel parameter for trend endpoints.
+// The value is validated by the trend methods before the request is sent.
+func WithAggregationLevel(level AggregationLevel) Option {
+	return WithString("aggregationLevel", string(level))
+}
+
+// WithTrendInterval sets the interval parameter for trend endpoints. The value is
+// validated by the trend methods before the request is sent.
+func WithTrendInterval(interval TrendInterval) Option {
+	return WithString("interval", string(interval))
+}
+
+// WithFields limits the blocks returned by endpoints that support projection to
+// the named fields, joined into the comma-separated fields parameter. Blank names
+// are dropped, and the option is a no-op when no fields remain. Unlike
+// WithSnapshotFields it never applies a default projection.
+func WithFields(fields ...string) Option {
+	return func(values url.Values) {
+		cleaned := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if f = strings.TrimSpace(f); f != "" {
+				cleaned = append(cleaned, f)
+			}
+		}
+		WithStringSlice("fields", cleaned, ",")(values)
+	}
+}
+
+// WithMinSchoolRating sets the minSchoolRating parameter, asking school searches
+// to return only schools whose overall rating is at least minRating. The school
+// swagger bundled in docs/attom does not list this filter, so when the server
+// ignores it apply FilterSchoolsByRating to the results. Non-positive values are
+// ignored.
+func WithMinSchoolRating(minRating float64) Option {
+	return func(values url.Values) {
+		if minRating <= 0 {
+			return
+		}
+		values.Set("minSchoolRating", strconv.FormatFloat(minRating, 'f', -1, 64))
+	}
+}
+
+// MinimalSnapshotFields is the projection requested by WithSnapshotFields when no
+// fields are supplied: the identifier, address, and location blocks needed for map pins.
+const MinimalSnapshotFields = "identifier,address,location"
+
+// WithSnapshotFields limits the blocks returned by snapshot endpoints to the named
+// fields, joined into the comma-separated fields parameter. Calling it with no
+// arguments requests MinimalSnapshotFields.
+func WithSnapshotFields(fields ...string) Option {
+	return func(values url.Values) {
+		cleaned := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if f = strings.TrimSpace(f); f != "" {
+				cleaned = append(cleaned, f)
+			}
+		}
+		if len(cleaned) == 0 {
+			values.Set("fields", MinimalSnapshotFields)
+			return
+		}
+		values.Set("fields", strings.Join(cleaned, ","))
+	}
+}
+
 // WithPage sets the page index for paginated responses.
 func WithPage(page int) Option {
 	return func(values url.Values) {
@@ -271,6 +517,22 @@ func WithOrderBy(field string) Option {
 	return WithString("orderby", field)
 }
 
+// WithSort sets the orderby parameter to field with an explicit direction, in
+// ATTOM's "field asc" / "field desc" form. field must be one of the OrderBy
+// constants; otherwise the option is a no-op. Use WithOrderBy for raw values.
+func WithSort(field string, descending bool) Option {
+	return func(values url.Values) {
+		if ValidateOrderBy(field) != nil {
+			return
+		}
+		direction := "asc"
+		if descending {
+			direction = "desc"
+		}
+		values.Set("orderby", field+" "+direction)
+	}
+}
+
 // WithAdditionalParam allows callers to supply custom string parameters.
 func WithAdditionalParam(key, value string) Option {
 	return WithString(key, value)
@@ -281,6 +543,24 @@ func WithWKTString(wktString string) Option {
 	return WithString("WKTString", wktString)
 }
 
+// WithBoundingBoxWKT sets the WKTString parameter to a closed WKT polygon
+// covering the rectangle between the two corners, for map-viewport searches on
+// endpoints that accept WKT geometries; use WithBoundingBox for endpoints that
+// take minLatitude/maxLatitude parameters. WKT lists coordinates as "longitude
+// latitude". The option is a no-op unless minLat < maxLat, minLon < maxLon, and
+// all coordinates are within range.
+func WithBoundingBoxWKT(minLat, minLon, maxLat, maxLon float64) Option {
+	if !(minLat < maxLat) || !(minLon < maxLon) {
+		return func(url.Values) {}
+	}
+	return WithWKTPolygon(
+		[2]float64{minLat, minLon},
+		[2]float64{minLat, maxLon},
+		[2]float64{maxLat, maxLon},
+		[2]float64{maxLat, minLon},
+	)
+}
+
 // WithStateID sets the StateId parameter.
 func WithStateID(stateID string) Option {
 	return WithString("StateId", stateID)
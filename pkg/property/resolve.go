@@ -0,0 +1,105 @@
+package property
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ResolveAttomID looks up address with GetPropertyID and returns the first
+// non-empty ATTOM ID in the response, ready to pass to WithAttomID. It returns an
+// error wrapping ErrNoResults when no identifier carries an ATTOM ID.
+func (s *Service) ResolveAttomID(ctx context.Context, address string, opts ...Option) (string, error) {
+	resp, err := s.GetPropertyID(ctx, address, opts...)
+	if err != nil {
+		return "", err
+	}
+	for _, id := range resp.Identifier {
+		if attomID := id.GetAttomID(); attomID != "" {
+			return attomID, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no ATTOM ID for address %q", ErrNoResults, address)
+}
+
+// UniqueAttomIDs returns the ATTOM IDs in i.Identifier in order of first
+// appearance, dropping repeats such as the same parcel listed under several
+// ObPropIDs. Nil identifiers and identifiers without an ATTOM ID are skipped.
+func (i *IDResponse) UniqueAttomIDs() []string {
+	if i == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(i.Identifier))
+	seen := make(map[string]struct{}, len(i.Identifier))
+	for _, id := range i.Identifier {
+		if id == nil || id.AttomID == nil {
+			continue
+		}
+		if _, dup := seen[*id.AttomID]; dup {
+			continue
+		}
+		seen[*id.AttomID] = struct{}{}
+		ids = append(ids, *id.AttomID)
+	}
+	return ids
+}
+
+// ResolveAddresses reads one single-line address per line from r and resolves each
+// to ATTOM identifiers with GetPropertyID, running up to concurrency lookups at once.
+// fn is invoked once per non-blank address with the identifiers or the lookup error;
+// calls to fn are serialized, so it does not need its own locking. Reading stops
+// when ctx is cancelled; lookups already in flight complete (typically with the
+// context error) before ResolveAddresses returns ctx.Err().
+func (s *Service) ResolveAddresses(ctx context.Context, r io.Reader, concurrency int, fn func(addr string, ids []*Identifier, err error)) error {
+	if r == nil {
+		return errors.New("property: ResolveAddresses requires a reader")
+	}
+	if fn == nil {
+		return errors.New("property: ResolveAddresses requires a callback")
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, concurrency)
+	)
+	scanner := bufio.NewScanner(r)
+scan:
+	for scanner.Scan() {
+		addr := strings.TrimSpace(scanner.Text())
+		if addr == "" {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			break scan
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			var ids []*Identifier
+			resp, err := s.GetPropertyID(ctx, addr)
+			if err == nil {
+				ids = resp.Identifier
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			fn(addr, ids, err)
+		}(addr)
+	}
+	wg.Wait()
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("property: reading addresses: %w", err)
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,146 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+func TestResolveAttomID(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr error
+	}{
+		{name: "single match", body: `{"status":{},"identifier":[{"attomId":"100"}]}`, want: "100"},
+		{name: "multiple matches", body: `{"status":{},"identifier":[{"id":"x"},{"attomId":"200"},{"attomId":"300"}]}`, want: "200"},
+		{name: "no match", body: `{"status":{"code":1,"msg":"SuccessWithoutResult"},"identifier":[]}`, wantErr: ErrNoResults},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{
+				t:              t,
+				expectedMethod: http.MethodGet,
+				expectedPath:   "/v4/property/id",
+				expectedQuery:  url.Values{"address": {"1 Main St, Denver, CO"}},
+				responseBody:   tt.body,
+			}
+			svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+			got, err := svc.ResolveAttomID(context.Background(), "1 Main St, Denver, CO")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("missing address", func(t *testing.T) {
+		svc := NewService(client.New("test-key", &mockHTTPClient{t: t}, client.WithBaseURL("https://example.com/")))
+		if _, err := svc.ResolveAttomID(context.Background(), ""); !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+}
+
+func TestResolveAddresses(t *testing.T) {
+	var calls atomic.Int32
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		if req.URL.Path != "/v4/property/id" {
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+		body := `{"status":{},"identifier":[{"attomId":"` + strings.ReplaceAll(req.URL.Query().Get("address"), " ", "-") + `"}]}`
+		code := http.StatusOK
+		if strings.HasPrefix(req.URL.Query().Get("address"), "missing") {
+			body, code = `{"status":{"msg":"SuccessWithoutResult"}}`, http.StatusNotFound
+		}
+		return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	input := "1 Main St, Springfield, IL\n\n  2 Oak Ave, Denver, CO  \nmissing address\n3 Pine Rd, Austin, TX\n"
+	got := map[string]string{}
+	var failed []string
+	err := svc.ResolveAddresses(context.Background(), strings.NewReader(input), 2, func(addr string, ids []*Identifier, err error) {
+		if err != nil {
+			failed = append(failed, addr)
+			return
+		}
+		if len(ids) != 1 || ids[0].AttomID == nil {
+			t.Errorf("expected one identifier for %q, got %v", addr, ids)
+			return
+		}
+		got[addr] = *ids[0].AttomID
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := calls.Load(); n != 4 {
+		t.Errorf("expected 4 lookups, got %d", n)
+	}
+	want := map[string]string{
+		"1 Main St, Springfield, IL": "1-Main-St,-Springfield,-IL",
+		"2 Oak Ave, Denver, CO":      "2-Oak-Ave,-Denver,-CO",
+		"3 Pine Rd, Austin, TX":      "3-Pine-Rd,-Austin,-TX",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d resolved addresses, got %v", len(want), got)
+	}
+	for addr, id := range want {
+		if got[addr] != id {
+			t.Errorf("address %q: expected %q, got %q", addr, id, got[addr])
+		}
+	}
+	if len(failed) != 1 || failed[0] != "missing address" {
+		t.Errorf("expected one failure for the missing address, got %v", failed)
+	}
+}
+
+func TestResolveAddressesCancelled(t *testing.T) {
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, req.Context().Err()
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := svc.ResolveAddresses(ctx, strings.NewReader("1 Main St\n2 Oak Ave\n"), 1, func(string, []*Identifier, error) {})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestResolveAddressesRequiresCallback(t *testing.T) {
+	svc := NewService(client.New("test-key", nil))
+	if err := svc.ResolveAddresses(context.Background(), strings.NewReader("1 Main St"), 1, nil); err == nil {
+		t.Fatal("expected error for nil callback")
+	}
+}
+
+func TestIDResponseUniqueAttomIDs(t *testing.T) {
+	var resp IDResponse
+	body := `{"identifier":[{"attomId":"200","obPropId":"1"},{"id":"x"},null,{"attomId":"100"},{"attomId":"200","obPropId":"2"},{"attomId":"300"},{"attomId":"100"}]}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	got := resp.UniqueAttomIDs()
+	if want := []string{"200", "100", "300"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if ids := (*IDResponse)(nil).UniqueAttomIDs(); ids != nil {
+		t.Errorf("expected nil for nil response, got %v", ids)
+	}
+}
@@ -0,0 +1,28 @@
+package property
+
+// TotalBaths returns the number of bathrooms. It prefers BathsTotal, then
+// ATTOM's BathsCalculated, and otherwise combines the component counts, counting
+// a half bath as 0.5 and a three-quarter bath as 0.75. It returns 0 when no bath
+// information is present.
+func (r *Rooms) TotalBaths() float64 {
+	if r == nil {
+		return 0
+	}
+	if r.BathsTotal != nil {
+		return r.BathsTotal.Float64()
+	}
+	if r.BathsCalculated != nil {
+		return r.BathsCalculated.Float64()
+	}
+	var total float64
+	if r.BathsFull != nil {
+		total += r.BathsFull.Float64()
+	}
+	if r.BathsHalf != nil {
+		total += 0.5 * r.BathsHalf.Float64()
+	}
+	if r.BathsThreeQuarter != nil {
+		total += 0.75 * r.BathsThreeQuarter.Float64()
+	}
+	return total
+}
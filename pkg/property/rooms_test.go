@@ -0,0 +1,42 @@
+package property
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRoomsTotalBaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  float64
+	}{
+		{name: "numeric total", input: `{"bathsTotal":2.5}`, want: 2.5},
+		{name: "string total", input: `{"bathsTotal":"2.5"}`, want: 2.5},
+		{name: "total wins over components", input: `{"bathsTotal":"3","bathsFull":1}`, want: 3},
+		{name: "calculated", input: `{"bathsCalculated":"1.75","bathsFull":1}`, want: 1.75},
+		{name: "numeric components", input: `{"bathsFull":2,"bathsHalf":1}`, want: 2.5},
+		{name: "string components", input: `{"bathsFull":"1","bathsHalf":"2","bathsThreeQuarter":"1"}`, want: 2.75},
+		{name: "empty string total falls back to zero", input: `{"bathsTotal":""}`, want: 0},
+		{name: "no bath data", input: `{"beds":3}`, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rooms Rooms
+			if err := json.Unmarshal([]byte(tt.input), &rooms); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := rooms.TotalBaths(); got != tt.want {
+				t.Errorf("TotalBaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("nil rooms", func(t *testing.T) {
+		var rooms *Rooms
+		if got := rooms.TotalBaths(); got != 0 {
+			t.Errorf("TotalBaths() = %v, want 0", got)
+		}
+	})
+}
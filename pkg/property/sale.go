@@ -0,0 +1,80 @@
+package property
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// saleAmountObject is the nested form of a sale amount, e.g. {"saleamt": 250000}.
+// saleamtcurr is used only when saleamt is absent or blank.
+type saleAmountObject struct {
+	SaleAmt     json.RawMessage `json:"saleamt,omitempty"`
+	SaleAmtCurr json.RawMessage `json:"saleamtcurr,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the sale amount either as a
+// flat number under "amount" or nested as "amount": {"saleamt": ...} and stores the
+// result in Amount. Amounts sent as "" leave Amount nil, in either form.
+func (s *Sale) UnmarshalJSON(data []byte) error {
+	type saleAlias Sale
+	aux := struct {
+		*saleAlias
+		Amount json.RawMessage `json:"amount,omitempty"`
+	}{saleAlias: (*saleAlias)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	s.Amount = nil
+	raw := bytes.TrimSpace(aux.Amount)
+	if len(raw) > 0 && raw[0] == '{' {
+		var nested saleAmountObject
+		if err := json.Unmarshal(raw, &nested); err != nil {
+			return fmt.Errorf("property: invalid sale amount %s: %w", raw, err)
+		}
+		amount, err := decodeSaleAmount(nested.SaleAmt)
+		if err == nil && amount == nil {
+			amount, err = decodeSaleAmount(nested.SaleAmtCurr)
+		}
+		s.Amount = amount
+		return err
+	}
+	amount, err := decodeSaleAmount(raw)
+	s.Amount = amount
+	return err
+}
+
+// decodeSaleAmount decodes a single sale amount value, returning nil when raw is
+// missing, null, or a blank string.
+func decodeSaleAmount(raw json.RawMessage) (*float64, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) || isBlankJSONString(raw) {
+		return nil, nil
+	}
+	var amount FlexFloat
+	if err := amount.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	v := amount.Float64()
+	return &v, nil
+}
+
+// SaleAmount returns the decoded sale amount. The boolean is false when the sale
+// or its amount is missing.
+func (s *Sale) SaleAmount() (float64, bool) {
+	if s == nil || s.Amount == nil {
+		return 0, false
+	}
+	return *s.Amount, true
+}
+
+// SaleAmountMoney returns the sale amount as Money, rounded to the nearest cent.
+// The boolean is false when the sale or its amount is missing.
+func (s *Sale) SaleAmountMoney() (Money, bool) {
+	amount, ok := s.SaleAmount()
+	if !ok {
+		return 0, false
+	}
+	return MoneyFromFloat(amount), true
+}
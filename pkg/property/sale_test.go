@@ -0,0 +1,73 @@
+package property
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSaleAmountDecoding(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   float64
+		wantOK bool
+	}{
+		{name: "flat amount", input: `{"saleDate":"2020-01-02","amount":250000}`, want: 250000, wantOK: true},
+		{name: "quoted flat amount", input: `{"amount":"250000"}`, want: 250000, wantOK: true},
+		{name: "nested saleamt", input: `{"amount":{"saleamt":310000,"salerecdate":"2021-05-01"}}`, want: 310000, wantOK: true},
+		{name: "nested saleamt wins over saleamtcurr", input: `{"amount":{"saleamt":310000,"saleamtcurr":315000}}`, want: 310000, wantOK: true},
+		{name: "nested saleamtcurr only", input: `{"amount":{"saleamtcurr":"315000"}}`, want: 315000, wantOK: true},
+		{name: "nested without amount", input: `{"amount":{"salerecdate":"2021-05-01"}}`},
+		{name: "blank flat amount", input: `{"amount":""}`},
+		{name: "whitespace flat amount", input: `{"amount":"  "}`},
+		{name: "blank nested saleamt", input: `{"amount":{"saleamt":""}}`},
+		{name: "blank nested saleamt falls back to saleamtcurr", input: `{"amount":{"saleamt":"","saleamtcurr":"315000"}}`, want: 315000, wantOK: true},
+		{name: "blank nested amounts", input: `{"amount":{"saleamt":"","saleamtcurr":" "}}`},
+		{name: "null amount", input: `{"amount":null}`},
+		{name: "missing amount", input: `{"saleDate":"2020-01-02"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sale Sale
+			if err := json.Unmarshal([]byte(tt.input), &sale); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, ok := sale.SaleAmount()
+			if ok != tt.wantOK {
+				t.Fatalf("SaleAmount() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("SaleAmount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("other fields decode", func(t *testing.T) {
+		var sale Sale
+		input := `{"saleDate":"2020-01-02","amount":{"saleamt":1},"mortgage":{"loanAmount":800}}`
+		if err := json.Unmarshal([]byte(input), &sale); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sale.SaleDate == nil || *sale.SaleDate != "2020-01-02" {
+			t.Errorf("expected saleDate to decode, got %v", sale.SaleDate)
+		}
+		if sale.Financing == nil {
+			t.Errorf("expected mortgage to decode")
+		}
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		var sale Sale
+		if err := json.Unmarshal([]byte(`{"amount":"n/a"}`), &sale); err == nil {
+			t.Errorf("expected error for non-numeric amount")
+		}
+	})
+
+	t.Run("nil sale", func(t *testing.T) {
+		var sale *Sale
+		if _, ok := sale.SaleAmount(); ok {
+			t.Errorf("expected ok=false for nil sale")
+		}
+	})
+}
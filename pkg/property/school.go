@@ -0,0 +1,18 @@
+package property
+
+// FilterSchoolsByRating returns the schools whose overall rating is at least
+// minRating, preserving their order. Schools without ratings or without an
+// overall rating are skipped, as are nil entries. It is the client-side
+// counterpart of WithMinSchoolRating.
+func FilterSchoolsByRating(schools []*School, minRating float64) []*School {
+	filtered := make([]*School, 0, len(schools))
+	for _, school := range schools {
+		if school == nil || school.Ratings == nil || school.Ratings.Overall == nil {
+			continue
+		}
+		if *school.Ratings.Overall >= minRating {
+			filtered = append(filtered, school)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,45 @@
+package property
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestWithMinSchoolRating(t *testing.T) {
+	vals := url.Values{}
+	WithMinSchoolRating(7.5)(vals)
+	if got := vals.Get("minSchoolRating"); got != "7.5" {
+		t.Errorf("expected '7.5', got %q", got)
+	}
+
+	for _, rating := range []float64{0, -1} {
+		vals := url.Values{}
+		WithMinSchoolRating(rating)(vals)
+		if vals.Has("minSchoolRating") {
+			t.Errorf("expected no-op for %v, got %q", rating, vals.Get("minSchoolRating"))
+		}
+	}
+}
+
+func TestFilterSchoolsByRating(t *testing.T) {
+	rated := func(name string, overall float64) *School {
+		return &School{Name: &name, Ratings: &SchoolRatings{Overall: &overall}}
+	}
+	high, exact, low := rated("High", 9), rated("Exact", 7), rated("Low", 4.5)
+	schools := []*School{
+		high,
+		nil,
+		{Ratings: nil},
+		{Ratings: &SchoolRatings{}},
+		low,
+		exact,
+	}
+
+	got := FilterSchoolsByRating(schools, 7)
+	if len(got) != 2 || got[0] != high || got[1] != exact {
+		t.Errorf("expected [High Exact], got %d schools", len(got))
+	}
+	if got := FilterSchoolsByRating(nil, 5); len(got) != 0 {
+		t.Errorf("expected empty result, got %v", got)
+	}
+}
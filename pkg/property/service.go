@@ -1,38 +1,121 @@
 package property
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"mime"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/my-eq/go-attom/pkg/client"
 )
 
 // Service provides access to ATTOM Property API resources.
 type Service struct {
-	client *client.Client
+	client              *client.Client
+	reportDeprecated    func(method string)
+	acceptFormat        string
+	defaultOpts         []Option
+	strictDecoding      bool
+	aggregateValidation bool
+	noResultsError      bool
+}
+
+// ServiceOption configures a Service.
+type ServiceOption func(*Service)
+
+// WithDefaultOptions registers options that are applied before the per-call options
+// of every request made by the service. Because per-call options run afterwards,
+// they override any parameter set by a default.
+func WithDefaultOptions(opts ...Option) ServiceOption {
+	return func(s *Service) {
+		s.defaultOpts = append(s.defaultOpts, opts...)
+	}
+}
+
+// WithDeprecationReporter registers fn to be called with the method name each time
+// a method backed by a deprecated ATTOM endpoint is invoked, such as
+// GetSchoolSnapshot, so teams can find and migrate remaining usages.
+func WithDeprecationReporter(fn func(method string)) ServiceOption {
+	return func(s *Service) {
+		s.reportDeprecated = fn
+	}
+}
+
+// WithAcceptFormat sets the Accept header sent by the service, either
+// AcceptHeaderJSON (the default) or AcceptHeaderXML. Responses whose Content-Type
+// is XML are decoded with encoding/xml into the same response models; XML
+// decoding is currently supported for GetPropertyDetail. Any other value makes
+// requests fail with a validation error.
+func WithAcceptFormat(format string) ServiceOption {
+	return func(s *Service) {
+		s.acceptFormat = format
+	}
+}
+
+// WithStrictDecoding makes the service reject responses containing fields that the
+// response models do not declare, so schema changes surface as errors instead of
+// being silently dropped. Unknown fields are rejected at any depth, including
+// inside models with their own UnmarshalJSON such as Assessment and Sale. Decode
+// errors in strict mode include the endpoint path. Values of the flexible scalar
+// types, such as FlexFloat, are decoded leniently as usual.
+func WithStrictDecoding(strict bool) ServiceOption {
+	return func(s *Service) {
+		s.strictDecoding = strict
+	}
+}
+
+// WithNoResultsError makes the service return ErrNoResults when a successful
+// response carries a status block reporting an empty result (see CheckStatus),
+// instead of returning an empty model. The decoded response is still populated.
+func WithNoResultsError(enabled bool) ServiceOption {
+	return func(s *Service) {
+		s.noResultsError = enabled
+	}
+}
+
+// deprecated notifies the configured deprecation reporter that method was called.
+func (s *Service) deprecated(method string) {
+	if s != nil && s.reportDeprecated != nil {
+		s.reportDeprecated(method)
+	}
 }
 
 // NewService constructs a Property API service using the provided ATTOM client.
-func NewService(c *client.Client) *Service {
+func NewService(c *client.Client, opts ...ServiceOption) *Service {
 	if c == nil {
 		return nil
 	}
-	return &Service{client: c}
+	s := &Service{client: c}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
+// maxAutoPages caps the number of pages fetched by helpers that page internally,
+// so a very large result set cannot trigger an unbounded scan.
+const maxAutoPages = 100
+
 // endpoint constants for Property API resources.
 const (
 	propertyBasePath         = "v4/property/"
 	saleBasePath             = "v4/transaction/"
 	assessmentBasePath       = "v4/property/"
 	assessmentHistoryPath    = "v4/property/history/"
-	avmBasePath              = "v4/property/"
-	avmHistoryBasePath       = "v4/property/"
-	attomAVMPath             = "v4/property/"
+	assessmentGeoPath        = "v4/property/assessment/snapshot"
+	avmBasePath              = "v4/property/avm/"
+	avmHistoryBasePath       = "v4/property/avmhistory/"
 	valuationBasePath        = "v4/property/"
 	salesHistoryBasePath     = "v4/transaction/"
 	salesTrendBasePath       = "v4/transaction/"
@@ -44,7 +127,8 @@ const (
 	enumerationsBasePath     = "v4/enumerations/"
 	areaBasePath             = "v4/area/"
 	poiBasePath              = "v4/neighborhood/poi"
-	communityBasePath        = "v4/neighborhood/neighborhood/community"
+	communityBasePath        = "v4/neighborhood/community"
+	locationLookupPath       = "v4/location/lookup"
 	parcelTilesBasePath      = "v4/parceltiles/"
 	preforeclosureBasePath   = "property/v3/preforeclosuredetails"
 )
@@ -56,15 +140,38 @@ func (s *Service) ensureClient() error {
 	return nil
 }
 
-func (s *Service) doGet(ctx context.Context, endpoint string, query url.Values, out interface{}) (err error) {
+func (s *Service) doGet(ctx context.Context, endpoint string, query url.Values, out interface{}) error {
+	return s.doRequest(ctx, http.MethodGet, endpoint, query, nil, out)
+}
+
+// doPost sends payload as a JSON request body and decodes the response into out.
+// The body is buffered so the request can be replayed by client retries.
+func (s *Service) doPost(ctx context.Context, endpoint string, query url.Values, payload, out interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("property: failed to encode request body: %w", err)
+	}
+	return s.doRequest(ctx, http.MethodPost, endpoint, query, bytes.NewReader(body), out)
+}
+
+// doRequest executes a request and decodes a successful response into out.
+func (s *Service) doRequest(ctx context.Context, method, endpoint string, query url.Values, body io.Reader, out interface{}) (err error) {
 	if err = s.ensureClient(); err != nil {
 		return err
 	}
+	if s.acceptFormat != "" {
+		if err = ValidateAcceptHeader(s.acceptFormat); err != nil {
+			return fmt.Errorf("property: %w", err)
+		}
+	}
 	var req *http.Request
-	req, err = s.client.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	req, err = s.client.NewRequest(ctx, method, endpoint, query, body)
 	if err != nil {
 		return fmt.Errorf("property: failed to build request: %w", err)
 	}
+	if s.acceptFormat != "" {
+		req.Header.Set("Accept", s.acceptFormat)
+	}
 	var resp *http.Response
 	resp, err = s.client.DoRequest(req)
 	if err != nil {
@@ -77,22 +184,7 @@ func (s *Service) doGet(ctx context.Context, endpoint string, query url.Values,
 	}()
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		rawBody, readErr := io.ReadAll(resp.Body)
-		apiErr := &Error{StatusCode: resp.StatusCode, Body: rawBody}
-		if readErr == nil && len(rawBody) > 0 {
-			var statusWrapper struct {
-				Status  *Status `json:"status,omitempty"`
-				Message string  `json:"message,omitempty"`
-			}
-			if unmarshalErr := json.Unmarshal(rawBody, &statusWrapper); unmarshalErr == nil {
-				apiErr.Status = statusWrapper.Status
-				apiErr.Message = statusWrapper.Message
-			}
-		}
-		if readErr != nil {
-			return fmt.Errorf("property: unable to read error response: %w", readErr)
-		}
-		return apiErr
+		return newAPIError(resp, endpoint, query)
 	}
 
 	if out == nil {
@@ -103,16 +195,117 @@ func (s *Service) doGet(ctx context.Context, endpoint string, query url.Values,
 		return nil
 	}
 
-	decoder := json.NewDecoder(resp.Body)
-	if decodeErr := decoder.Decode(out); decodeErr != nil {
+	if s.isXMLResponse(resp) {
+		if decodeErr := xml.NewDecoder(resp.Body).Decode(out); decodeErr != nil {
+			return fmt.Errorf("property: failed to decode XML response: %w", decodeErr)
+		}
+		return s.checkNoResults(out)
+	}
+
+	if s.strictDecoding {
+		body, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return fmt.Errorf("property: failed to read response body: %w", readErr)
+		}
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.DisallowUnknownFields()
+		decodeErr := decoder.Decode(out)
+		if decodeErr == nil {
+			decodeErr = checkUnknownFields(body, reflect.TypeOf(out), "")
+		}
+		if decodeErr != nil {
+			return fmt.Errorf("property: failed to decode response from %s: %w", endpoint, decodeErr)
+		}
+		return s.checkNoResults(out)
+	}
+
+	if decodeErr := json.NewDecoder(resp.Body).Decode(out); decodeErr != nil {
 		return fmt.Errorf("property: failed to decode response: %w", decodeErr)
 	}
-	return err
+	return s.checkNoResults(out)
+}
+
+// checkNoResults applies CheckStatus to a decoded response when WithNoResultsError
+// is enabled and the response exposes a status block.
+func (s *Service) checkNoResults(out interface{}) error {
+	if !s.noResultsError {
+		return nil
+	}
+	if withStatus, ok := out.(interface{ GetStatus() *Status }); ok {
+		return CheckStatus(withStatus.GetStatus())
+	}
+	return nil
+}
+
+// GetRaw performs a GET request against endpoint with the service defaults and
+// opts applied, and returns the live response without checking its status or
+// decoding its body. It is intended for callers that need response headers,
+// such as rate-limit counters or transaction IDs. The caller must close the
+// response body.
+func (s *Service) GetRaw(ctx context.Context, endpoint string, opts []Option) (*http.Response, error) {
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, endpoint, s.applyOptions(opts), nil)
+	if err != nil {
+		return nil, fmt.Errorf("property: failed to build request: %w", err)
+	}
+	resp, err := s.client.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("property: request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// isXMLResponse reports whether resp should be decoded as XML: its Content-Type
+// is XML, or it has none and XML was requested with WithAcceptFormat.
+func (s *Service) isXMLResponse(resp *http.Response) bool {
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		return s.acceptFormat == AcceptHeaderXML
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == AcceptHeaderXML || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml")
+}
+
+// newAPIError converts a non-2xx response to a request for endpoint into an
+// *Error, decoding the ATTOM status block when the body contains one.
+func newAPIError(resp *http.Response, endpoint string, query url.Values) error {
+	rawBody, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("property: unable to read error response: %w", readErr)
+	}
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       rawBody,
+		Err:        statusError(resp.StatusCode),
+		Endpoint:   endpoint,
+		Query:      redactQuery(query),
+	}
+	if len(rawBody) > 0 {
+		var statusWrapper struct {
+			Status  *Status `json:"status,omitempty"`
+			Message string  `json:"message,omitempty"`
+		}
+		if unmarshalErr := json.Unmarshal(rawBody, &statusWrapper); unmarshalErr == nil {
+			apiErr.Status = statusWrapper.Status
+			apiErr.Message = statusWrapper.Message
+		}
+	}
+	return apiErr
 }
 
 func (s *Service) get(ctx context.Context, endpoint string, opts []Option, validator func(url.Values) error, out interface{}) error {
-	query := applyOptions(opts)
-	if validator != nil {
+	query := s.applyOptions(opts)
+	if s != nil && s.aggregateValidation {
+		if err := validateAggregated(query, validator); err != nil {
+			return err
+		}
+	} else if validator != nil {
 		if err := validator(query); err != nil {
 			return err
 		}
@@ -120,6 +313,45 @@ func (s *Service) get(ctx context.Context, endpoint string, opts []Option, valid
 	return s.doGet(ctx, endpoint, query, out)
 }
 
+// get performs a GET request against endpoint and decodes the response into a new T.
+// It is the generic form of Service.get for methods that return the decoded
+// response unchanged.
+func get[T any](ctx context.Context, s *Service, endpoint string, opts []Option, validator func(url.Values) error) (*T, error) {
+	var resp T
+	if err := s.get(ctx, endpoint, opts, validator, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get performs a GET request against an arbitrary endpoint path, relative to the
+// client's base URL, and decodes the JSON response into a new T. It lets callers
+// reach endpoints this package does not wrap yet while reusing the service's
+// authentication, default options, and error handling; non-2xx responses are
+// returned as *Error. No parameter validation is performed.
+func Get[T any](ctx context.Context, s *Service, endpoint string, opts ...Option) (*T, error) {
+	return get[T](ctx, s, endpoint, opts, nil)
+}
+
+// applyOptions builds the query for a request, applying the service defaults
+// before the per-call options.
+func (s *Service) applyOptions(opts []Option) url.Values {
+	if s == nil || len(s.defaultOpts) == 0 {
+		return applyOptions(opts)
+	}
+	all := make([]Option, 0, len(s.defaultOpts)+len(opts))
+	all = append(all, s.defaultOpts...)
+	return applyOptions(append(all, opts...))
+}
+
+// requireAddressLines requires both address1 and address2.
+func requireAddressLines(values url.Values) error {
+	if values.Get("address1") != "" && values.Get("address2") != "" {
+		return nil
+	}
+	return fmt.Errorf("%w: address1 and address2 required", ErrMissingParameter)
+}
+
 func requireAny(values url.Values, keys ...string) error {
 	for _, key := range keys {
 		if v := values.Get(key); v != "" {
@@ -155,6 +387,98 @@ func ensureGeoContext(values url.Values) error {
 	return fmt.Errorf("%w: provide address or latitude/longitude", ErrMissingParameter)
 }
 
+// validateCoordinates ensures latitude and longitude are finite and within range.
+func validateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return fmt.Errorf("property: invalid latitude %v (must be between -90 and 90)", latitude)
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("property: invalid longitude %v (must be between -180 and 180)", longitude)
+	}
+	return nil
+}
+
+// chainValidators runs each validator in order and returns the first error.
+func chainValidators(validators ...func(url.Values) error) func(url.Values) error {
+	return func(values url.Values) error {
+		for _, validate := range validators {
+			if validate == nil {
+				continue
+			}
+			if err := validate(values); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// validatePropertyTypeParam checks every pipe-separated propertytype value against
+// the known PropertyType enumeration so that typos fail before a request is sent.
+func validatePropertyTypeParam(values url.Values) error {
+	raw := values.Get("propertytype")
+	if raw == "" {
+		return nil
+	}
+	for _, propertyType := range strings.Split(raw, "|") {
+		if err := ValidatePropertyType(propertyType); err != nil {
+			return fmt.Errorf("property: %w", err)
+		}
+	}
+	return nil
+}
+
+// requireTrendParams validates the parameters shared by the trend endpoints.
+func requireTrendParams(values url.Values) error {
+	if values.Get("geoIdV4") == "" {
+		return fmt.Errorf("%w: geoIdV4 required", ErrMissingParameter)
+	}
+	if level := values.Get("aggregationLevel"); level != "" {
+		if err := ValidateAggregationLevel(AggregationLevel(level)); err != nil {
+			return fmt.Errorf("property: %w", err)
+		}
+	}
+	if interval := values.Get("interval"); interval != "" {
+		if err := ValidateTrendInterval(TrendInterval(interval)); err != nil {
+			return fmt.Errorf("property: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateBoundingBox reports whether values carry a bounding box and, if so,
+// whether it is complete and well-ordered.
+func validateBoundingBox(values url.Values) (bool, error) {
+	keys := []string{"minLatitude", "minLongitude", "maxLatitude", "maxLongitude"}
+	present := 0
+	for _, key := range keys {
+		if values.Get(key) != "" {
+			present++
+		}
+	}
+	if present == 0 {
+		return false, nil
+	}
+	if present != len(keys) {
+		return true, fmt.Errorf("%w: bounding box requires %v", ErrMissingParameter, keys)
+	}
+	coords := make([]float64, len(keys))
+	for i, key := range keys {
+		v, err := strconv.ParseFloat(values.Get(key), 64)
+		if err != nil {
+			return true, fmt.Errorf("property: invalid bounding box %s: %w", key, err)
+		}
+		coords[i] = v
+	}
+	if coords[0] >= coords[2] {
+		return true, fmt.Errorf("property: invalid bounding box: minLatitude %v must be less than maxLatitude %v", coords[0], coords[2])
+	}
+	if coords[1] >= coords[3] {
+		return true, fmt.Errorf("property: invalid bounding box: minLongitude %v must be less than maxLongitude %v", coords[1], coords[3])
+	}
+	return true, nil
+}
+
 // GetPropertyID retrieves ATTOM property identifiers for a supplied address.
 func (s *Service) GetPropertyID(ctx context.Context, address string, opts ...Option) (*IDResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -184,6 +508,21 @@ func (s *Service) GetPropertyDetail(ctx context.Context, opts ...Option) (*Detai
 	return &resp, nil
 }
 
+// GetPropertyDetailAudited retrieves property detail and returns the first property
+// together with the full response status block for audit trails. The property is
+// nil when the response contains no records.
+func (s *Service) GetPropertyDetailAudited(ctx context.Context, opts ...Option) (*Property, *Status, error) {
+	resp, err := s.GetPropertyDetail(ctx, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var prop *Property
+	if len(resp.Property) > 0 {
+		prop = resp.Property[0]
+	}
+	return prop, resp.Status, nil
+}
+
 // GetPropertyAddress retrieves property address details by identifier.
 func (s *Service) GetPropertyAddress(ctx context.Context, opts ...Option) (*AddressResponse, error) {
 	var resp AddressResponse
@@ -217,6 +556,19 @@ func (s *Service) GetPropertySnapshot(ctx context.Context, opts ...Option) (*Sna
 		if values.Get("postalCode") != "" {
 			return nil
 		}
+		// WKT polygon, which replaces the point and bounding box searches
+		if values.Get("WKTString") != "" {
+			for _, key := range []string{"latitude", "longitude", "minLatitude", "minLongitude", "maxLatitude", "maxLongitude"} {
+				if values.Get(key) != "" {
+					return fmt.Errorf("property: WKTString cannot be combined with %s", key)
+				}
+			}
+			return nil
+		}
+		// bounding box (min/max latitude and longitude)
+		if ok, err := validateBoundingBox(values); ok || err != nil {
+			return err
+		}
 		// latitude + longitude (+ radius required)
 		lat := values.Get("latitude")
 		lon := values.Get("longitude")
@@ -226,10 +578,33 @@ func (s *Service) GetPropertySnapshot(ctx context.Context, opts ...Option) (*Sna
 			}
 			return fmt.Errorf("%w: radius required with latitude/longitude", ErrMissingParameter)
 		}
-		return fmt.Errorf("%w: valid property identifier required (attomId/attomid, id, FIPS+(APN/apn), address, address1/address2, postalCode, or latitude/longitude+radius)", ErrMissingParameter)
+		return fmt.Errorf("%w: valid property identifier required (attomId/attomid, id, FIPS+(APN/apn), address, address1/address2, postalCode, latitude/longitude+radius, bounding box, or WKTString)", ErrMissingParameter)
 	}
 	var resp SnapshotResponse
-	err := s.get(ctx, propertyBasePath+"snapshot", opts, validator, &resp)
+	err := s.get(ctx, propertyBasePath+"snapshot", opts, chainValidators(validator, validatePropertyTypeParam), &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPropertiesNearPoint retrieves the properties within radiusMiles of the given
+// coordinates. The property detail endpoint only accepts identifiers, so the
+// search runs against the snapshot endpoint, whose response has the same shape
+// as DetailResponse. Options such as WithPropertyType or WithPage narrow or page
+// the results.
+func (s *Service) GetPropertiesNearPoint(ctx context.Context, latitude, longitude, radiusMiles float64, opts ...Option) (*DetailResponse, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+	allOpts := append([]Option{WithLatitudeLongitude(latitude, longitude), WithRadius(radiusMiles)}, opts...)
+	var resp DetailResponse
+	err := s.get(ctx, propertyBasePath+"snapshot", allOpts, chainValidators(func(values url.Values) error {
+		if values.Get("radius") == "" {
+			return fmt.Errorf("%w: positive radius required", ErrMissingParameter)
+		}
+		return nil
+	}, validatePropertyTypeParam), &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +627,13 @@ func (s *Service) GetBasicProfile(ctx context.Context, address string, opts ...O
 	return &resp, nil
 }
 
+// GetBasicProfileByLines is like GetBasicProfile but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetBasicProfileByLines(ctx context.Context, address1, address2 string, opts ...Option) (*ProfileResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[ProfileResponse](ctx, s, propertyBasePath+"basicprofile", allOpts, requireAddressLines)
+}
+
 // GetExpandedProfile retrieves the expanded property profile.
 func (s *Service) GetExpandedProfile(ctx context.Context, opts ...Option) (*ProfileResponse, error) {
 	var resp ProfileResponse
@@ -267,6 +649,31 @@ func (s *Service) GetExpandedProfile(ctx context.Context, opts ...Option) (*Prof
 	return &resp, nil
 }
 
+// GetExpandedProfileByAttomID retrieves the expanded property profile for an ATTOM ID.
+func (s *Service) GetExpandedProfileByAttomID(ctx context.Context, attomID string, opts ...Option) (*ProfileResponse, error) {
+	attomID = strings.TrimSpace(attomID)
+	if attomID == "" {
+		return nil, fmt.Errorf("%w: attomid required", ErrMissingParameter)
+	}
+	return s.GetExpandedProfile(ctx, append([]Option{WithAttomID(attomID)}, opts...)...)
+}
+
+// GetExpandedProfileWithSchoolsByAttomID retrieves the expanded profile for an ATTOM ID
+// and attaches the property's assigned schools from the detailwithschools endpoint.
+// The options apply to the profile request only.
+func (s *Service) GetExpandedProfileWithSchoolsByAttomID(ctx context.Context, attomID string, opts ...Option) (*ExpandedProfileWithSchools, error) {
+	profile, err := s.GetExpandedProfileByAttomID(ctx, attomID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var schools WithSchoolsResponse
+	err = s.get(ctx, propertyBasePath+"detailwithschools", []Option{WithAttomID(strings.TrimSpace(attomID))}, requirePropertyIdentifier, &schools)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpandedProfileWithSchools{Profile: profile, Schools: schools.Schools}, nil
+}
+
 // GetDetailWithSchools retrieves property detail including school information.
 func (s *Service) GetDetailWithSchools(ctx context.Context, address string, opts ...Option) (*WithSchoolsResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -283,6 +690,13 @@ func (s *Service) GetDetailWithSchools(ctx context.Context, address string, opts
 	return &resp, nil
 }
 
+// GetDetailWithSchoolsByLines is like GetDetailWithSchools but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetDetailWithSchoolsByLines(ctx context.Context, address1, address2 string, opts ...Option) (*WithSchoolsResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[WithSchoolsResponse](ctx, s, propertyBasePath+"detailwithschools", allOpts, requireAddressLines)
+}
+
 // GetDetailMortgage retrieves property detail with mortgage information.
 func (s *Service) GetDetailMortgage(ctx context.Context, address string, opts ...Option) (*MortgageResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -299,6 +713,13 @@ func (s *Service) GetDetailMortgage(ctx context.Context, address string, opts ..
 	return &resp, nil
 }
 
+// GetDetailMortgageByLines is like GetDetailMortgage but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetDetailMortgageByLines(ctx context.Context, address1, address2 string, opts ...Option) (*MortgageResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[MortgageResponse](ctx, s, propertyBasePath+"detailmortgage", allOpts, requireAddressLines)
+}
+
 // GetDetailOwner retrieves property detail with owner information.
 func (s *Service) GetDetailOwner(ctx context.Context, address string, opts ...Option) (*OwnerResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -315,6 +736,13 @@ func (s *Service) GetDetailOwner(ctx context.Context, address string, opts ...Op
 	return &resp, nil
 }
 
+// GetDetailOwnerByLines is like GetDetailOwner but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetDetailOwnerByLines(ctx context.Context, address1, address2 string, opts ...Option) (*OwnerResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[OwnerResponse](ctx, s, propertyBasePath+"detailowner", allOpts, requireAddressLines)
+}
+
 // GetDetailMortgageOwner retrieves property detail with mortgage and ownership information.
 func (s *Service) GetDetailMortgageOwner(ctx context.Context, address string, opts ...Option) (*MortgageOwnerResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -331,6 +759,13 @@ func (s *Service) GetDetailMortgageOwner(ctx context.Context, address string, op
 	return &resp, nil
 }
 
+// GetDetailMortgageOwnerByLines is like GetDetailMortgageOwner but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetDetailMortgageOwnerByLines(ctx context.Context, address1, address2 string, opts ...Option) (*MortgageOwnerResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[MortgageOwnerResponse](ctx, s, propertyBasePath+"detailmortgageowner", allOpts, requireAddressLines)
+}
+
 // GetBuildingPermits retrieves building permit records for a property.
 func (s *Service) GetBuildingPermits(ctx context.Context, address string, opts ...Option) (*BuildingPermitsResponse, error) {
 	allOpts := append([]Option{WithAddress(address)}, opts...)
@@ -347,6 +782,13 @@ func (s *Service) GetBuildingPermits(ctx context.Context, address string, opts .
 	return &resp, nil
 }
 
+// GetBuildingPermitsByLines is like GetBuildingPermits but takes the address as separate
+// address1 (street) and address2 (city, state, ZIP) lines, both of which are required.
+func (s *Service) GetBuildingPermitsByLines(ctx context.Context, address1, address2 string, opts ...Option) (*BuildingPermitsResponse, error) {
+	allOpts := append([]Option{WithAddressLines(address1, address2)}, opts...)
+	return get[BuildingPermitsResponse](ctx, s, propertyBasePath+"buildingpermits", allOpts, requireAddressLines)
+}
+
 // GetSaleDetail retrieves sale detail information.
 func (s *Service) GetSaleDetail(ctx context.Context, opts ...Option) (*SaleDetailResponse, error) {
 	var resp SaleDetailResponse
@@ -360,7 +802,7 @@ func (s *Service) GetSaleDetail(ctx context.Context, opts ...Option) (*SaleDetai
 // GetSaleSnapshot retrieves sale snapshot information.
 func (s *Service) GetSaleSnapshot(ctx context.Context, opts ...Option) (*SaleSnapshotResponse, error) {
 	var resp SaleSnapshotResponse
-	err := s.get(ctx, saleBasePath+"snapshot", opts, requirePropertyIdentifier, &resp)
+	err := s.get(ctx, saleBasePath+"snapshot", opts, chainValidators(requirePropertyIdentifier, validatePropertyTypeParam), &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -380,7 +822,25 @@ func (s *Service) GetAssessmentDetail(ctx context.Context, opts ...Option) (*Ass
 // GetAssessmentSnapshot retrieves assessment snapshot information.
 func (s *Service) GetAssessmentSnapshot(ctx context.Context, opts ...Option) (*AssessmentSnapshotResponse, error) {
 	var resp AssessmentSnapshotResponse
-	err := s.get(ctx, assessmentBasePath+"snapshot", opts, requirePropertyIdentifier, &resp)
+	err := s.get(ctx, assessmentBasePath+"snapshot", opts, chainValidators(requirePropertyIdentifier, validatePropertyTypeParam), &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAssessmentSnapshotGeo retrieves assessment snapshots for all properties
+// within a specific geography. Narrow the results with options such as
+// WithAssessedValueRange or WithAssessmentYearRange.
+func (s *Service) GetAssessmentSnapshotGeo(ctx context.Context, geoIDV4 string, opts ...Option) (*AssessmentSnapshotResponse, error) {
+	allOpts := append([]Option{WithGeoIDV4(geoIDV4)}, opts...)
+	var resp AssessmentSnapshotResponse
+	err := s.get(ctx, assessmentGeoPath, allOpts, chainValidators(func(values url.Values) error {
+		if values.Get("geoIdV4") != "" {
+			return nil
+		}
+		return fmt.Errorf("%w: geoIdV4 required", ErrMissingParameter)
+	}, validatePropertyTypeParam), &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +860,7 @@ func (s *Service) GetAssessmentHistory(ctx context.Context, opts ...Option) (*As
 // GetAVMSnapshot retrieves AVM snapshot values for a property.
 func (s *Service) GetAVMSnapshot(ctx context.Context, opts ...Option) (*AVMSnapshotResponse, error) {
 	var resp AVMSnapshotResponse
-	err := s.get(ctx, avmBasePath+"snapshot", opts, requirePropertyIdentifier, &resp)
+	err := s.get(ctx, avmBasePath+"snapshot", opts, chainValidators(requirePropertyIdentifier, validatePropertyTypeParam), &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -410,7 +870,7 @@ func (s *Service) GetAVMSnapshot(ctx context.Context, opts ...Option) (*AVMSnaps
 // GetAttomAVMDetail retrieves detailed ATTOM AVM information.
 func (s *Service) GetAttomAVMDetail(ctx context.Context, opts ...Option) (*AttomAVMDetailResponse, error) {
 	var resp AttomAVMDetailResponse
-	err := s.get(ctx, attomAVMPath+"detail", opts, requirePropertyIdentifier, &resp)
+	err := s.get(ctx, avmBasePath+"detail", opts, requirePropertyIdentifier, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -467,9 +927,10 @@ func (s *Service) GetSalesHistoryBasic(ctx context.Context, opts ...Option) (*Sa
 	return &resp, nil
 }
 
-// GetSalesHistoryExpanded retrieves the expanded sales history data set.
-func (s *Service) GetSalesHistoryExpanded(ctx context.Context, opts ...Option) (*SalesHistoryResponse, error) {
-	var resp SalesHistoryResponse
+// GetSalesHistoryExpanded retrieves the expanded sales history data set, including
+// buyer and seller names, the title company, and financing for each sale.
+func (s *Service) GetSalesHistoryExpanded(ctx context.Context, opts ...Option) (*ExpandedSalesHistoryResponse, error) {
+	var resp ExpandedSalesHistoryResponse
 	err := s.get(ctx, salesHistoryBasePath+"expandedhistory", opts, requirePropertyIdentifier, &resp)
 	if err != nil {
 		return nil, err
@@ -480,12 +941,7 @@ func (s *Service) GetSalesHistoryExpanded(ctx context.Context, opts ...Option) (
 // GetSalesTrendSnapshot retrieves geographic sales trend data.
 func (s *Service) GetSalesTrendSnapshot(ctx context.Context, opts ...Option) (*SalesTrendSnapshotResponse, error) {
 	var resp SalesTrendSnapshotResponse
-	err := s.get(ctx, salesTrendBasePath+"snapshot", opts, func(values url.Values) error {
-		if values.Get("geoIdV4") == "" {
-			return fmt.Errorf("%w: geoIdV4 required", ErrMissingParameter)
-		}
-		return nil
-	}, &resp)
+	err := s.get(ctx, salesTrendBasePath+"snapshot", opts, requireTrendParams, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -495,12 +951,7 @@ func (s *Service) GetSalesTrendSnapshot(ctx context.Context, opts ...Option) (*S
 // GetTransactionSalesTrend retrieves transaction-based sales trend data.
 func (s *Service) GetTransactionSalesTrend(ctx context.Context, opts ...Option) (*TransactionSalesTrendResponse, error) {
 	var resp TransactionSalesTrendResponse
-	err := s.get(ctx, transactionTrendBasePath+"salestrend", opts, func(values url.Values) error {
-		if values.Get("geoIdV4") == "" {
-			return fmt.Errorf("%w: geoIdV4 required", ErrMissingParameter)
-		}
-		return nil
-	}, &resp)
+	err := s.get(ctx, transactionTrendBasePath+"salestrend", opts, requireTrendParams, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -517,6 +968,24 @@ func (s *Service) SearchSchools(ctx context.Context, opts ...Option) (*SchoolSea
 	return &resp, nil
 }
 
+// SearchSchoolsNear retrieves the schools around the supplied coordinates from
+// the school search endpoint, ranked by proximity. ATTOM does not publish an
+// endpoint that resolves attendance zones from a point; use GetDetailWithSchools
+// for the attendance-zone schools of a known address. The coordinates are applied
+// after opts, so opts cannot override them.
+func (s *Service) SearchSchoolsNear(ctx context.Context, latitude, longitude float64, opts ...Option) (*SchoolSearchResponse, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+	allOpts := append(append([]Option{}, opts...), WithLatitudeLongitude(latitude, longitude))
+	var resp SchoolSearchResponse
+	err := s.get(ctx, schoolBasePath+"search", allOpts, ensureGeoContext, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetSchoolProfile retrieves detailed school profile information.
 func (s *Service) GetSchoolProfile(ctx context.Context, schoolID string, opts ...Option) (*SchoolProfileResponse, error) {
 	allOpts := append([]Option{WithString("schoolId", schoolID)}, opts...)
@@ -567,6 +1036,7 @@ func (s *Service) GetSchoolDetailWithSchools(ctx context.Context, address string
 
 // GetSchoolSnapshot retrieves schools within a defined radius from a point (deprecated endpoint).
 func (s *Service) GetSchoolSnapshot(ctx context.Context, latitude, longitude, radius string, fileTypeText string, opts ...Option) (*SchoolSnapshotResponse, error) {
+	s.deprecated("GetSchoolSnapshot")
 	allOpts := append([]Option{
 		WithString("latitude", latitude),
 		WithString("longitude", longitude),
@@ -589,60 +1059,47 @@ func (s *Service) GetSchoolSnapshot(ctx context.Context, latitude, longitude, ra
 }
 
 // GetSchoolDetail retrieves details about a particular school (deprecated endpoint).
-//
-//nolint:dupl // similar code patterns shared across school endpoints
 func (s *Service) GetSchoolDetail(ctx context.Context, schoolID string, opts ...Option) (*SchoolDetailResponse, error) {
+	s.deprecated("GetSchoolDetail")
 	allOpts := append([]Option{WithString("id", schoolID)}, opts...)
-	var resp SchoolDetailResponse
-	err := s.get(ctx, schoolBasePath+"detail", allOpts, func(values url.Values) error {
+	return get[SchoolDetailResponse](ctx, s, schoolBasePath+"detail", allOpts, func(values url.Values) error {
 		if values.Get("id") != "" {
 			return nil
 		}
 		return fmt.Errorf("%w: school id required", ErrMissingParameter)
-	}, &resp)
-	if err != nil {
-		return nil, err
-	}
-	return &resp, nil
+	})
 }
 
 // GetSchoolDistrictDetail retrieves details about a particular school district (deprecated endpoint).
-//
-//nolint:dupl // similar code patterns shared across school endpoints
 func (s *Service) GetSchoolDistrictDetail(ctx context.Context, districtID string, opts ...Option) (*SchoolDistrictDetailResponse, error) {
+	s.deprecated("GetSchoolDistrictDetail")
 	allOpts := append([]Option{WithString("id", districtID)}, opts...)
-	var resp SchoolDistrictDetailResponse
-	err := s.get(ctx, schoolBasePath+"districtdetail", allOpts, func(values url.Values) error {
+	return get[SchoolDistrictDetailResponse](ctx, s, schoolBasePath+"districtdetail", allOpts, func(values url.Values) error {
 		if values.Get("id") != "" {
 			return nil
 		}
 		return fmt.Errorf("%w: district id required", ErrMissingParameter)
-	}, &resp)
-	if err != nil {
-		return nil, err
-	}
-	return &resp, nil
+	})
 }
 
 // GetHomeEquity retrieves estimated home equity for a property.
-//
-//nolint:dupl // pattern duplicated with other address-based endpoints
 func (s *Service) GetHomeEquity(ctx context.Context, address1, address2 string, opts ...Option) (*HomeEquityResponse, error) {
 	allOpts := append([]Option{
 		WithString("address1", address1),
 		WithString("address2", address2),
 	}, opts...)
-	var resp HomeEquityResponse
-	err := s.get(ctx, valuationBasePath+"homeequity", allOpts, func(values url.Values) error {
-		if values.Get("address1") != "" && values.Get("address2") != "" {
-			return nil
+	return get[HomeEquityResponse](ctx, s, valuationBasePath+"homeequity", allOpts, requireAddressLines)
+}
+
+// GetHomeEquityByAttomID retrieves estimated home equity for a property by ATTOM ID.
+func (s *Service) GetHomeEquityByAttomID(ctx context.Context, attomID string, opts ...Option) (*HomeEquityResponse, error) {
+	allOpts := append([]Option{WithAttomID(strings.TrimSpace(attomID))}, opts...)
+	return get[HomeEquityResponse](ctx, s, valuationBasePath+"homeequity", allOpts, func(values url.Values) error {
+		if values.Get("attomid") == "" {
+			return fmt.Errorf("%w: attomid required", ErrMissingParameter)
 		}
-		return fmt.Errorf("%w: address1 and address2 required", ErrMissingParameter)
-	}, &resp)
-	if err != nil {
-		return nil, err
-	}
-	return &resp, nil
+		return nil
+	})
 }
 
 // GetAVMSnapshotGeo retrieves AVM snapshot values for all properties within a specific geography.
@@ -658,12 +1115,12 @@ func (s *Service) GetAVMSnapshotGeo(ctx context.Context, geoIDV4, minAVMValue, m
 		allOpts = append(allOpts, WithString("propertytype", propertyType))
 	}
 	var resp AVMSnapshotGeoResponse
-	err := s.get(ctx, avmBasePath+"snapshot", allOpts, func(values url.Values) error {
+	err := s.get(ctx, avmBasePath+"snapshot", allOpts, chainValidators(func(values url.Values) error {
 		if values.Get("geoIdV4") != "" {
 			return nil
 		}
 		return fmt.Errorf("%w: geoIdV4 required", ErrMissingParameter)
-	}, &resp)
+	}, validatePropertyTypeParam), &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -671,24 +1128,12 @@ func (s *Service) GetAVMSnapshotGeo(ctx context.Context, geoIDV4, minAVMValue, m
 }
 
 // GetAVMHistoryByAddress retrieves AVM history for a property by address.
-//
-//nolint:dupl // pattern duplicated with other address-based endpoints
 func (s *Service) GetAVMHistoryByAddress(ctx context.Context, address1, address2 string, opts ...Option) (*AVMHistoryResponse, error) {
 	allOpts := append([]Option{
 		WithString("address1", address1),
 		WithString("address2", address2),
 	}, opts...)
-	var resp AVMHistoryResponse
-	err := s.get(ctx, avmHistoryBasePath+"detail", allOpts, func(values url.Values) error {
-		if values.Get("address1") != "" && values.Get("address2") != "" {
-			return nil
-		}
-		return fmt.Errorf("%w: address1 and address2 required", ErrMissingParameter)
-	}, &resp)
-	if err != nil {
-		return nil, err
-	}
-	return &resp, nil
+	return get[AVMHistoryResponse](ctx, s, avmHistoryBasePath+"detail", allOpts, requireAddressLines)
 }
 
 // GetAllEventsDetail retrieves all events information for a property.
@@ -791,6 +1236,45 @@ func (s *Service) GetCountyLookup(ctx context.Context, stateID string, opts ...O
 	return &resp, nil
 }
 
+// CountyFIPS resolves a county name within a state to its FIPS code using GetCountyLookup.
+// Names are compared case-insensitively and a trailing "County" is ignored, so
+// "cook", "Cook County", and "COOK" all match. ErrCountyNotFound is returned when
+// nothing matches and ErrAmbiguousCounty when several counties with different
+// FIPS codes match.
+func (s *Service) CountyFIPS(ctx context.Context, state, countyName string) (string, error) {
+	want := normalizeCountyName(countyName)
+	if want == "" {
+		return "", fmt.Errorf("%w: county name required", ErrMissingParameter)
+	}
+	resp, err := s.GetCountyLookup(ctx, strings.TrimSpace(state))
+	if err != nil {
+		return "", err
+	}
+	var fips string
+	for _, county := range resp.Counties {
+		if county == nil || county.Name == nil || normalizeCountyName(*county.Name) != want {
+			continue
+		}
+		if county.FIPS == nil || *county.FIPS == "" {
+			continue
+		}
+		if fips != "" && fips != *county.FIPS {
+			return "", fmt.Errorf("%w: %q in %s matches FIPS %s and %s", ErrAmbiguousCounty, countyName, state, fips, *county.FIPS)
+		}
+		fips = *county.FIPS
+	}
+	if fips == "" {
+		return "", fmt.Errorf("%w: %q in %s", ErrCountyNotFound, countyName, state)
+	}
+	return fips, nil
+}
+
+// normalizeCountyName lowercases a county name and strips a trailing "county".
+func normalizeCountyName(name string) string {
+	n := strings.ToLower(strings.Join(strings.Fields(name), " "))
+	return strings.TrimSpace(strings.TrimSuffix(n, " county"))
+}
+
 // GetStateLookup retrieves all states and their IDs.
 func (s *Service) GetStateLookup(ctx context.Context, opts ...Option) (*StateResponse, error) {
 	var resp StateResponse
@@ -866,7 +1350,7 @@ func (s *Service) GetCommunity(ctx context.Context, opts ...Option) (*CommunityR
 // GetLocationLookup retrieves location lookup information.
 func (s *Service) GetLocationLookup(ctx context.Context, opts ...Option) (*LocationLookupResponse, error) {
 	var resp LocationLookupResponse
-	err := s.get(ctx, communityBasePath+"location/lookup", opts, nil, &resp)
+	err := s.get(ctx, locationLookupPath, opts, nil, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -879,7 +1363,7 @@ func (s *Service) GetSaleComparablesByAddress(ctx context.Context, street, city,
 	var resp SaleComparablesResponse
 	err := s.get(ctx, fmt.Sprintf("%saddress/%s/%s/%s/%s/%s", saleComparablesBasePath, url.PathEscape(street), url.PathEscape(city), url.PathEscape(county), url.PathEscape(state), url.PathEscape(zip)), allOpts, func(values url.Values) error {
 		if values.Get("address") != "" && street != "" && city != "" && county != "" && state != "" && zip != "" {
-			return nil
+			return validateComparablesParams(values)
 		}
 		return fmt.Errorf("%w: address components required", ErrMissingParameter)
 	}, &resp)
@@ -895,7 +1379,7 @@ func (s *Service) GetSaleComparablesByAPN(ctx context.Context, apn, county, stat
 	var resp SaleComparablesResponse
 	err := s.get(ctx, fmt.Sprintf("%sapn/%s/%s/%s", saleComparablesBasePath, url.PathEscape(apn), url.PathEscape(county), url.PathEscape(state)), allOpts, func(values url.Values) error {
 		if values.Get("APN") != "" && county != "" && state != "" {
-			return nil
+			return validateComparablesParams(values)
 		}
 		return fmt.Errorf("%w: APN, county, and state required", ErrMissingParameter)
 	}, &resp)
@@ -909,13 +1393,83 @@ func (s *Service) GetSaleComparablesByAPN(ctx context.Context, apn, county, stat
 func (s *Service) GetSaleComparablesByPropID(ctx context.Context, propID string, opts ...Option) (*SaleComparablesResponse, error) {
 	allOpts := append([]Option{WithAttomID(propID)}, opts...)
 	var resp SaleComparablesResponse
-	err := s.get(ctx, saleComparablesBasePath+"propid/"+propID, allOpts, requirePropertyIdentifier, &resp)
+	err := s.get(ctx, saleComparablesBasePath+"propid/"+propID, allOpts, chainValidators(requirePropertyIdentifier, validateComparablesParams), &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// GetAllSaleComparablesByPropID pages through GetSaleComparablesByPropID and returns
+// every comparable in a single response. The Status block of the first page is
+// kept so Status.Total reflects the full result count. Paging stops when a page
+// comes back empty, the reported total has been read, or after 100 pages.
+func (s *Service) GetAllSaleComparablesByPropID(ctx context.Context, propID string, opts ...Option) (*SaleComparablesResponse, error) {
+	var all SaleComparablesResponse
+	for page := 1; page <= maxAutoPages; page++ {
+		pageOpts := append(append([]Option{}, opts...), WithPage(page))
+		resp, err := s.GetSaleComparablesByPropID(ctx, propID, pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+		if page == 1 {
+			all.Status = resp.Status
+		}
+		if len(resp.SaleComparables) == 0 {
+			break
+		}
+		all.SaleComparables = append(all.SaleComparables, resp.SaleComparables...)
+		if all.Status != nil && all.Status.Total != nil && len(all.SaleComparables) >= *all.Status.Total {
+			break
+		}
+	}
+	return &all, nil
+}
+
+// GetPropertyWithComparables fetches the subject property detail and its sale
+// comparables concurrently, as used for comparative market analyses. The options
+// apply to the comparables request only. When one request fails the result of the
+// other is still returned alongside the error; when both fail the errors are joined.
+func (s *Service) GetPropertyWithComparables(ctx context.Context, attomID string, opts ...Option) (*Property, []*SaleComparable, error) {
+	attomID = strings.TrimSpace(attomID)
+	if attomID == "" {
+		return nil, nil, fmt.Errorf("%w: attomid required", ErrMissingParameter)
+	}
+
+	var (
+		wg                  sync.WaitGroup
+		detail              *DetailResponse
+		comps               *SaleComparablesResponse
+		detailErr, compsErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		detail, detailErr = s.GetPropertyDetail(ctx, WithAttomID(attomID))
+	}()
+	go func() {
+		defer wg.Done()
+		comps, compsErr = s.GetSaleComparablesByPropID(ctx, attomID, opts...)
+	}()
+	wg.Wait()
+
+	var (
+		prop        *Property
+		comparables []*SaleComparable
+	)
+	if detailErr != nil {
+		detailErr = fmt.Errorf("property: subject detail: %w", detailErr)
+	} else if len(detail.Property) > 0 {
+		prop = detail.Property[0]
+	}
+	if compsErr != nil {
+		compsErr = fmt.Errorf("property: sale comparables: %w", compsErr)
+	} else {
+		comparables = comps.SaleComparables
+	}
+	return prop, comparables, errors.Join(detailErr, compsErr)
+}
+
 // GetTransportationNoise retrieves transportation noise information.
 func (s *Service) GetTransportationNoise(ctx context.Context, attomID string, opts ...Option) (*TransportationNoiseResponse, error) {
 	allOpts := append([]Option{WithAttomID(attomID)}, opts...)
@@ -932,21 +1486,75 @@ func (s *Service) GetTransportationNoise(ctx context.Context, attomID string, op
 	return &resp, nil
 }
 
-// GetParcelTiles retrieves parcel tiles data.
-func (s *Service) GetParcelTiles(ctx context.Context, z, x, y int, format string, opts ...Option) (*ParcelTilesResponse, error) {
-	var resp ParcelTilesResponse
-	endpoint := fmt.Sprintf("%s%d/%d/%d.%s", parcelTilesBasePath, z, x, y, format)
-	err := s.get(ctx, endpoint, opts, nil, &resp)
+// GetParcelTiles retrieves a parcel tile and returns its raw bytes and content
+// type. The tile is a PNG image or a vector tile depending on format, which must
+// be one of the TileFormat constants. Use GetParcelTileBytes for conditional
+// requests with an ETag.
+func (s *Service) GetParcelTiles(ctx context.Context, z, x, y int, format string, opts ...Option) ([]byte, string, error) {
+	tile, err := s.GetParcelTileBytes(ctx, z, x, y, format, "", opts...)
 	if err != nil {
+		return nil, "", err
+	}
+	return tile.Data, tile.ContentType, nil
+}
+
+// GetParcelTileBytes retrieves the raw bytes of a single parcel tile.
+//
+// When etag is non-empty it is sent as If-None-Match; a 304 response yields
+// ErrNotModified so callers can keep serving their cached tile.
+func (s *Service) GetParcelTileBytes(ctx context.Context, z, x, y int, format, etag string, opts ...Option) (tile *ParcelTileData, err error) {
+	if err = s.ensureClient(); err != nil {
 		return nil, err
 	}
-	return &resp, nil
+	if format == "" {
+		return nil, fmt.Errorf("%w: tile format required", ErrMissingParameter)
+	}
+	if err = ValidateTileFormat(format); err != nil {
+		return nil, fmt.Errorf("property: %w", err)
+	}
+	endpoint := fmt.Sprintf("%s%d/%d/%d.%s", parcelTilesBasePath, z, x, y, format)
+	var req *http.Request
+	query := s.applyOptions(opts)
+	req, err = s.client.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("property: failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "*/*")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	var resp *http.Response
+	resp, err = s.client.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("property: request failed: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("property: failed to close response body: %w", closeErr)
+		}
+	}()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, ErrNotModified
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(resp, endpoint, query)
+	}
+	data, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return nil, fmt.Errorf("property: failed to read tile body: %w", readErr)
+	}
+	return &ParcelTileData{
+		Data:        data,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        resp.Header.Get("ETag"),
+	}, nil
 }
 
 // GetPreforeclosureDetails retrieves pre-foreclosure details for a property.
-func (s *Service) GetPreforeclosureDetails(ctx context.Context, attomID string, opts ...Option) (*PreforeclosureResponse, error) {
+func (s *Service) GetPreforeclosureDetails(ctx context.Context, attomID string, opts ...Option) (*PreforeclosureDetailsResponse, error) {
 	allOpts := append([]Option{WithAttomID(attomID)}, opts...)
-	var resp PreforeclosureResponse
+	var resp PreforeclosureDetailsResponse
 	err := s.get(ctx, preforeclosureBasePath, allOpts, func(values url.Values) error {
 		if values.Get("attomid") == "" {
 			return fmt.Errorf("%w: attomid required", ErrMissingParameter)
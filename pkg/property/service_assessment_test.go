@@ -51,6 +51,30 @@ func TestAssessmentEndpoints(t *testing.T) {
 				return svc.GetAssessmentSnapshot(ctx)
 			},
 		},
+		{
+			name:         "GetAssessmentSnapshotGeo",
+			expectedPath: "/v4/property/assessment/snapshot",
+			expectedQuery: url.Values{
+				"geoIdV4":         {"geo-3"},
+				"minAssdTtlValue": {"100000"},
+				"maxAssdTtlValue": {"250000.5"},
+			},
+			responseBody: `{"status":{},"property":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetAssessmentSnapshotGeo(ctx, "geo-3", WithAssessedValueRange(100000, 250000.5))
+			},
+		},
+		{
+			name:                  "GetAssessmentSnapshotGeo_Error_NoGeoID",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "geoIdV4 required",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetAssessmentSnapshotGeo(ctx, "", WithAssessedValueRange(100000, 0))
+			},
+		},
 		{
 			name:          "GetAssessmentHistory",
 			expectedPath:  "/v4/property/history/",
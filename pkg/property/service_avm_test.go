@@ -2,8 +2,11 @@ package property
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestAVMEndpoints(t *testing.T) {
@@ -13,7 +16,7 @@ func TestAVMEndpoints(t *testing.T) {
 	tests := []TestCase{
 		{
 			name:          "GetAVMSnapshot",
-			expectedPath:  "/v4/property/snapshot",
+			expectedPath:  "/v4/property/avm/snapshot",
 			expectedQuery: url.Values{"attomid": {"100"}},
 			responseBody:  `{"status":{},"avm":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
@@ -33,7 +36,7 @@ func TestAVMEndpoints(t *testing.T) {
 		},
 		{
 			name:          "GetAttomAVMDetail",
-			expectedPath:  "/v4/property/detail",
+			expectedPath:  "/v4/property/avm/detail",
 			expectedQuery: url.Values{"attomid": {"100"}},
 			responseBody:  `{"status":{},"attomAvm":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
@@ -53,7 +56,7 @@ func TestAVMEndpoints(t *testing.T) {
 		},
 		{
 			name:          "GetAVMHistory",
-			expectedPath:  "/v4/property/detail",
+			expectedPath:  "/v4/property/avmhistory/detail",
 			expectedQuery: url.Values{"attomid": {"100"}},
 			responseBody:  `{"status":{},"avmHistory":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
@@ -94,7 +97,7 @@ func TestAVMEndpoints(t *testing.T) {
 		// --- NEW ENDPOINT TESTS ---
 		{
 			name:          "GetAVMSnapshotGeo",
-			expectedPath:  "/v4/property/snapshot",
+			expectedPath:  "/v4/property/avm/snapshot",
 			expectedQuery: url.Values{"geoIdV4": {"geo-2"}, "minavmvalue": {"100000"}, "maxavmvalue": {"500000"}, "propertytype": {"SFR"}},
 			responseBody:  `{"status":{},"avm":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
@@ -114,7 +117,7 @@ func TestAVMEndpoints(t *testing.T) {
 		},
 		{
 			name:          "GetAVMHistoryByAddress",
-			expectedPath:  "/v4/property/detail",
+			expectedPath:  "/v4/property/avmhistory/detail",
 			expectedQuery: url.Values{"address1": {"123 Main St"}, "address2": {"Springfield, IL"}},
 			responseBody:  `{"status":{},"avmHistory":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
@@ -152,6 +155,48 @@ func TestAVMEndpoints(t *testing.T) {
 				return svc.GetHomeEquity(ctx, "", "Springfield, IL")
 			},
 		},
+		{
+			name:                  "GetAVMSnapshotGeo_Error_InvalidPropertyType",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "invalid property type",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetAVMSnapshotGeo(ctx, "geo-2", "", "", "SINGLE FAMILY")
+			},
+		},
+		{
+			name:                  "GetAssessmentSnapshot_Error_InvalidPropertyType",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "invalid property type",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetAssessmentSnapshot(ctx, WithAttomID("100"), WithPropertyType("HOUSE"))
+			},
+		},
+		{
+			name:          "GetHomeEquityByAttomID",
+			expectedPath:  "/v4/property/homeequity",
+			expectedQuery: url.Values{"attomid": {"184196315"}},
+			responseBody:  `{"status":{},"homeEquity":150000.50}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetHomeEquityByAttomID(ctx, "184196315")
+			},
+		},
+		{
+			name:                  "GetHomeEquityByAttomID_Error_NoAttomID",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "attomid required",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetHomeEquityByAttomID(ctx, "  ")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -159,5 +204,25 @@ func TestAVMEndpoints(t *testing.T) {
 	}
 }
 
+func TestGetHomeEquityByAttomIDDecode(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/homeequity",
+		expectedQuery:  url.Values{"attomid": {"184196315"}},
+		responseBody:   `{"status":{"code":0},"homeEquity":150000.50}`,
+	}
+	c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+	svc := NewService(c)
+
+	resp, err := svc.GetHomeEquityByAttomID(context.Background(), " 184196315 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.HomeEquity == nil || *resp.HomeEquity != 150000.50 {
+		t.Fatalf("expected homeEquity 150000.50, got %v", resp.HomeEquity)
+	}
+}
+
 // ...existing code...
 // AVM endpoint tests will be moved here.
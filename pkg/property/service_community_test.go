@@ -2,15 +2,18 @@ package property
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestCommunityEndpoints(t *testing.T) {
 	tests := []TestCase{
 		{
 			name:                  "GetCommunity",
-			expectedPath:          "/v4/neighborhood/neighborhood/community",
+			expectedPath:          "/v4/neighborhood/community",
 			expectedQuery:         url.Values{"latitude": {"40.7128"}, "longitude": {"-74.006"}},
 			responseBody:          `{"status":{},"community":[{}]}`,
 			expectError:           false,
@@ -21,7 +24,7 @@ func TestCommunityEndpoints(t *testing.T) {
 		},
 		{
 			name:                  "GetLocationLookup",
-			expectedPath:          "/v4/neighborhood/neighborhood/communitylocation/lookup",
+			expectedPath:          "/v4/location/lookup",
 			expectedQuery:         url.Values{},
 			responseBody:          `{"status":{},"locationLookup":[{}]}`,
 			expectError:           false,
@@ -30,7 +33,66 @@ func TestCommunityEndpoints(t *testing.T) {
 				return svc.GetLocationLookup(ctx)
 			},
 		},
+		{
+			name:          "GetLocationLookup_WithGeoIDV4",
+			expectedPath:  "/v4/location/lookup",
+			expectedQuery: url.Values{"geoIdV4": {"geo-1"}},
+			responseBody:  `{"status":{},"location":[{"id":"geo-1"}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetLocationLookup(ctx, WithGeoIDV4("geo-1"))
+			},
+		},
 	}
 
 	runEndpointTests(t, "CommunityEndpoints", tests)
 }
+
+func TestGetCommunityDecodesScores(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/neighborhood/community",
+		expectedQuery:  url.Values{"geoIdV4": {"geo-1"}},
+		responseBody: `{
+			"status":{"code":0},
+			"community":[{
+				"name":"Capitol Hill",
+				"neighborhood":{
+					"crime":{"crime_Index":142,"burglary_Index":118.5,"motor_Vehicle_Theft_Index":201},
+					"affordability":{"affordability_Index":87,"median_Household_Income":68250,"median_Home_Value":512000,"median_Gross_Rent":1640},
+					"commute":{"median_Travel_Time_To_Work_Mi":24,"travel_Time_To_Work_90_Or_More_Mi_Pct":2.5},
+					"airQuality":{"air_Pollution_Index":88,"ozone_Index":97},
+					"naturalDisasters":{"hail_Index":130,"tornado_Index":45}
+				}
+			}]
+		}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetCommunity(context.Background(), WithGeoIDV4("geo-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Communities) != 1 {
+		t.Fatalf("expected 1 community, got %d", len(resp.Communities))
+	}
+	scores := resp.Communities[0].Scores
+	if scores == nil {
+		t.Fatal("expected neighborhood scores to be decoded")
+	}
+	if c := scores.Crime; c == nil || *c.CrimeIndex != 142 || *c.BurglaryIndex != 118.5 || *c.MotorVehicleTheftIndex != 201 {
+		t.Errorf("unexpected crime scores: %+v", c)
+	}
+	if a := scores.Affordability; a == nil || *a.AffordabilityIndex != 87 || *a.MedianHouseholdIncome != 68250 || *a.MedianHomeValue != 512000 || *a.MedianGrossRent != 1640 {
+		t.Errorf("unexpected affordability scores: %+v", a)
+	}
+	if c := scores.Commute; c == nil || *c.MedianTravelTimeToWorkMinutes != 24 || *c.TravelTime90PlusPct != 2.5 {
+		t.Errorf("unexpected commute scores: %+v", c)
+	}
+	if a := scores.AirQuality; a == nil || *a.AirPollutionIndex != 88 || *a.OzoneIndex != 97 {
+		t.Errorf("unexpected air quality scores: %+v", a)
+	}
+	if n := scores.NaturalDisasters; n == nil || *n.HailIndex != 130 || *n.TornadoIndex != 45 {
+		t.Errorf("unexpected natural disaster scores: %+v", n)
+	}
+}
@@ -2,9 +2,12 @@ package property
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/url"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestLookupEndpoints(t *testing.T) {
@@ -114,3 +117,59 @@ func TestLookupEndpoints(t *testing.T) {
 		runServiceTest(ctx, t, tt)
 	}
 }
+
+func TestCountyFIPS(t *testing.T) {
+	const body = `{"status":{},"county":[
+		{"name":"Cook County","fips":"17031"},
+		{"name":"Lake","fips":"17097"},
+		{"name":"Lake County","fips":"17098"},
+		{"name":"DuPage","fips":"17043"},
+		{"name":"DuPage County","fips":"17043"}
+	]}`
+
+	tests := []struct {
+		name    string
+		county  string
+		want    string
+		wantErr error
+	}{
+		{name: "exact suffix match", county: "Cook County", want: "17031"},
+		{name: "case insensitive without suffix", county: "cook", want: "17031"},
+		{name: "duplicate entries with same FIPS", county: "DUPAGE", want: "17043"},
+		{name: "miss", county: "Kings", wantErr: ErrCountyNotFound},
+		{name: "ambiguous", county: "lake", wantErr: ErrAmbiguousCounty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{
+				t:              t,
+				expectedMethod: http.MethodGet,
+				expectedPath:   "/v4/area/county/lookup",
+				expectedQuery:  url.Values{"StateId": {"IL"}},
+				responseBody:   body,
+			}
+			svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+			got, err := svc.CountyFIPS(context.Background(), "IL", tt.county)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected FIPS %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("empty county name", func(t *testing.T) {
+		svc := NewService(client.New("test-key", nil, client.WithBaseURL("https://example.com/")))
+		if _, err := svc.CountyFIPS(context.Background(), "IL", "  "); !errors.Is(err, ErrMissingParameter) {
+			t.Fatalf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+}
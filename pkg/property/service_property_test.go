@@ -2,8 +2,15 @@ package property
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestPropertyEndpoints(t *testing.T) {
@@ -58,6 +65,26 @@ func TestPropertyEndpoints(t *testing.T) {
 				return svc.GetBasicProfile(ctx, "123 Main St")
 			},
 		},
+		{
+			name:          "GetBasicProfileByLines",
+			expectedPath:  "/v4/property/basicprofile",
+			expectedQuery: url.Values{"address1": {"123 Main St"}, "address2": {"Denver, CO 80202"}},
+			responseBody:  `{"status":{},"property":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetBasicProfileByLines(ctx, "123 Main St", "Denver, CO 80202")
+			},
+		},
+		{
+			name:                  "GetBasicProfileByLines_Error_MissingLine",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "address1 and address2 required",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetBasicProfileByLines(ctx, "123 Main St", "")
+			},
+		},
 		{
 			name:          "GetExpandedProfile",
 			expectedPath:  "/v4/property/expandedprofile",
@@ -85,6 +112,26 @@ func TestPropertyEndpoints(t *testing.T) {
 				return svc.GetDetailMortgage(ctx, "123 Main St")
 			},
 		},
+		{
+			name:          "GetDetailMortgageByLines",
+			expectedPath:  "/v4/property/detailmortgage",
+			expectedQuery: url.Values{"address1": {"123 Main St"}, "address2": {"Denver, CO 80202"}},
+			responseBody:  `{"status":{},"property":[{}],"mortgage":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetDetailMortgageByLines(ctx, "123 Main St", "Denver, CO 80202")
+			},
+		},
+		{
+			name:                  "GetDetailMortgageByLines_Error_MissingLine",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "address1 and address2 required",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetDetailMortgageByLines(ctx, "123 Main St", "")
+			},
+		},
 		{
 			name:          "GetDetailOwner",
 			expectedPath:  "/v4/property/detailowner",
@@ -112,20 +159,11 @@ func TestPropertyEndpoints(t *testing.T) {
 				return svc.GetBuildingPermits(ctx, "123 Main St")
 			},
 		},
-		{
-			name:          "GetParcelTiles",
-			expectedPath:  "/v4/parceltiles/10/512/341.png",
-			expectedQuery: url.Values{},
-			responseBody:  `{"status":{},"parcelTiles":[{}]}`,
-			call: func(ctx context.Context, svc *Service) (interface{}, error) {
-				return svc.GetParcelTiles(ctx, 10, 512, 341, "png")
-			},
-		},
 		{
 			name:          "GetPreforeclosureDetails",
 			expectedPath:  "/property/v3/preforeclosuredetails",
 			expectedQuery: url.Values{"attomid": {"100"}},
-			responseBody:  `{"status":{},"preforeclosure":[{}]}`,
+			responseBody:  `{"status":{},"preforeclosureDetail":[{}]}`,
 			call: func(ctx context.Context, svc *Service) (interface{}, error) {
 				return svc.GetPreforeclosureDetails(ctx, "100")
 			},
@@ -136,3 +174,522 @@ func TestPropertyEndpoints(t *testing.T) {
 		runServiceTest(ctx, t, tt)
 	}
 }
+
+func TestGetDetailMortgageWithHistory(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/detailmortgage",
+		expectedQuery:  url.Values{"address": {"123 Main St"}, "mortgageHistory": {"true"}},
+		responseBody: `{
+			"status":{"code":0,"total":1},
+			"property":[{"identifier":{"attomId":"100"}}],
+			"mortgage":[{"lenderName":"Current Bank","loanAmount":250000,"interestRate":"6.25%"}],
+			"mortgageHistory":[
+				{"lenderName":"Prior Bank","loanAmount":200000,"interestRate":4.5},
+				{"lenderName":"Original Bank","loanAmount":150000}
+			]
+		}`,
+	}
+	c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+	svc := NewService(c)
+
+	resp, err := svc.GetDetailMortgage(context.Background(), "123 Main St", WithMortgageHistory(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Mortgage) != 1 || *resp.Mortgage[0].LenderName != "Current Bank" {
+		t.Fatalf("expected one current mortgage, got %+v", resp.Mortgage)
+	}
+	if resp.Mortgage[0].InterestRate == nil || resp.Mortgage[0].InterestRate.Float64() != 6.25 {
+		t.Errorf("expected current interest rate 6.25, got %v", resp.Mortgage[0].InterestRate)
+	}
+	if len(resp.MortgageHistory) != 2 {
+		t.Fatalf("expected two historical mortgages, got %d", len(resp.MortgageHistory))
+	}
+	if *resp.MortgageHistory[1].LenderName != "Original Bank" || *resp.MortgageHistory[1].LoanAmount != 150000 {
+		t.Errorf("unexpected historical mortgage: %+v", resp.MortgageHistory[1])
+	}
+}
+
+func TestWithMortgageHistory(t *testing.T) {
+	vals := url.Values{}
+	WithMortgageHistory(false)(vals)
+	if len(vals) != 0 {
+		t.Errorf("expected no values when disabled, got %v", vals)
+	}
+	WithMortgageHistory(true)(vals)
+	if vals.Get("mortgageHistory") != "true" {
+		t.Errorf("expected 'true', got %q", vals.Get("mortgageHistory"))
+	}
+}
+
+func TestGetParcelTileBytes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("200 with etag", func(t *testing.T) {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/v4/parceltiles/10/512/341.png" {
+				t.Fatalf("unexpected path %s", req.URL.Path)
+			}
+			if got := req.Header.Get("If-None-Match"); got != "" {
+				t.Fatalf("expected no If-None-Match header, got %q", got)
+			}
+			header := make(http.Header)
+			header.Set("ETag", `"abc123"`)
+			header.Set("Content-Type", "image/png")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader("\x89PNG")),
+			}, nil
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+		tile, err := svc.GetParcelTileBytes(ctx, 10, 512, 341, "png", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(tile.Data) != "\x89PNG" {
+			t.Errorf("unexpected tile data %q", tile.Data)
+		}
+		if tile.ETag != `"abc123"` {
+			t.Errorf("expected etag %q, got %q", `"abc123"`, tile.ETag)
+		}
+		if tile.ContentType != "image/png" {
+			t.Errorf("expected content type image/png, got %q", tile.ContentType)
+		}
+	})
+
+	t.Run("304 not modified", func(t *testing.T) {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if got := req.Header.Get("If-None-Match"); got != `"abc123"` {
+				t.Fatalf("expected If-None-Match %q, got %q", `"abc123"`, got)
+			}
+			return &http.Response{
+				StatusCode: http.StatusNotModified,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+		tile, err := svc.GetParcelTileBytes(ctx, 10, 512, 341, "png", `"abc123"`)
+		if !errors.Is(err, ErrNotModified) {
+			t.Fatalf("expected ErrNotModified, got %v", err)
+		}
+		if tile != nil {
+			t.Errorf("expected nil tile on 304")
+		}
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		mock := &mockHTTPClient{t: t, statusCode: http.StatusNotFound, responseBody: `{"message":"tile not found"}`}
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+		_, err := svc.GetParcelTileBytes(ctx, 10, 512, 341, "png", "")
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected *Error with 404, got %v", err)
+		}
+	})
+
+	t.Run("missing format", func(t *testing.T) {
+		svc := NewService(client.New("test-key", nil))
+		_, err := svc.GetParcelTileBytes(ctx, 10, 512, 341, "", "")
+		if !errors.Is(err, ErrMissingParameter) {
+			t.Fatalf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+}
+
+func TestGetParcelTiles(t *testing.T) {
+	ctx := context.Background()
+	png := "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\xff"
+
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v4/parceltiles/10/512/341.png" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		header := make(http.Header)
+		header.Set("Content-Type", "image/png")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(png)),
+		}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	data, contentType, err := svc.GetParcelTiles(ctx, 10, 512, 341, TileFormatPNG)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != png {
+		t.Errorf("tile bytes altered: got %q", data)
+	}
+	if contentType != "image/png" {
+		t.Errorf("expected content type image/png, got %q", contentType)
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		svc := NewService(client.New("test-key", nil))
+		if _, _, err := svc.GetParcelTiles(ctx, 10, 512, 341, "json"); err == nil || !strings.Contains(err.Error(), "invalid tile format") {
+			t.Fatalf("expected invalid tile format error, got %v", err)
+		}
+	})
+}
+
+func TestGetPropertyDetailAudited(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/detail",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		responseBody: `{
+			"status":{"version":"1.0.0","code":0,"msg":"SuccessWithResult","total":1,"transactionId":"abc-123"},
+			"property":[{"identifier":{"attomId":"100"}},{"identifier":{"attomId":"200"}}]
+		}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	prop, status, err := svc.GetPropertyDetailAudited(ctx, WithAttomID("100"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prop == nil || prop.Identifier == nil || *prop.Identifier.AttomID != "100" {
+		t.Fatalf("expected first property with attomId 100, got %+v", prop)
+	}
+	if status == nil {
+		t.Fatalf("expected status to be populated")
+	}
+	if *status.Version != "1.0.0" || *status.Code != 0 || *status.TransactionID != "abc-123" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+
+	t.Run("no properties", func(t *testing.T) {
+		mock.responseBody = `{"status":{"code":1,"msg":"SuccessWithoutResult"},"property":[]}`
+		prop, status, err := svc.GetPropertyDetailAudited(ctx, WithAttomID("100"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if prop != nil {
+			t.Errorf("expected nil property, got %+v", prop)
+		}
+		if status == nil || *status.Code != 1 {
+			t.Errorf("expected status code 1, got %+v", status)
+		}
+	})
+
+	t.Run("missing identifier", func(t *testing.T) {
+		_, _, err := svc.GetPropertyDetailAudited(ctx)
+		if !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+}
+
+func TestGetExpandedProfileByAttomID(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/expandedprofile",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		responseBody:   `{"status":{},"property":[{"identifier":{"attomId":"100"}}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetExpandedProfileByAttomID(ctx, " 100 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Property) != 1 || *resp.Property[0].Identifier.AttomID != "100" {
+		t.Errorf("unexpected profile: %+v", resp.Property)
+	}
+
+	if _, err := svc.GetExpandedProfileByAttomID(ctx, ""); !errors.Is(err, ErrMissingParameter) {
+		t.Errorf("expected ErrMissingParameter, got %v", err)
+	}
+}
+
+func TestGetExpandedProfileWithSchoolsByAttomID(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		if got := req.URL.Query().Get("attomid"); got != "100" {
+			t.Fatalf("expected attomid 100 on %s, got %q", req.URL.Path, got)
+		}
+		body := `{"status":{},"property":[{"identifier":{"attomId":"100"}}]}`
+		if req.URL.Path == "/v4/property/detailwithschools" {
+			body = `{"status":{},"property":[{}],"school":[{"name":"Lincoln Elementary"},{"name":"Central High"}]}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetExpandedProfileWithSchoolsByAttomID(ctx, "100")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/v4/property/expandedprofile" || paths[1] != "/v4/property/detailwithschools" {
+		t.Errorf("unexpected request sequence: %v", paths)
+	}
+	if resp.Profile == nil || len(resp.Profile.Property) != 1 {
+		t.Errorf("expected profile to be populated")
+	}
+	if len(resp.Schools) != 2 || *resp.Schools[1].Name != "Central High" {
+		t.Errorf("unexpected schools: %+v", resp.Schools)
+	}
+}
+
+func TestGetPropertySnapshotWithSnapshotFields(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/snapshot",
+		expectedQuery:  url.Values{"postalCode": {"62701"}, "fields": {MinimalSnapshotFields}},
+		responseBody: `{"status":{},"property":[{
+			"identifier":{"attomId":"100"},
+			"address":{"line1":"1 Main St","postalCode":"62701"},
+			"location":{"lat":39.78,"lon":-89.65}
+		}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	resp, err := svc.GetPropertySnapshot(context.Background(), WithPostalCode("62701"), WithSnapshotFields())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Property) != 1 {
+		t.Fatalf("expected 1 property, got %d", len(resp.Property))
+	}
+	p := resp.Property[0]
+	if p.Identifier == nil || p.Identifier.AttomID == nil || *p.Identifier.AttomID != "100" {
+		t.Fatalf("expected identifier to decode: %+v", p.Identifier)
+	}
+	if p.Address == nil || p.Location == nil || p.Location.Latitude == nil || *p.Location.Latitude != 39.78 {
+		t.Fatalf("expected address and location to decode: %+v", p)
+	}
+	if p.Building != nil || p.Sale != nil || p.Assessment != nil {
+		t.Errorf("expected projected blocks to be absent: %+v", p)
+	}
+}
+
+func TestGetPropertiesNearPoint(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/snapshot",
+		expectedQuery:  url.Values{"latitude": {"39.7392"}, "longitude": {"-104.9903"}, "radius": {"0.5"}, "propertytype": {"SFR"}},
+		responseBody:   `{"status":{"total":2},"property":[{"identifier":{"attomId":"100"}},{"identifier":{"attomId":"200"}}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetPropertiesNearPoint(ctx, 39.7392, -104.9903, 0.5, WithPropertyType("SFR"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Property) != 2 || *resp.Property[1].Identifier.AttomID != "200" {
+		t.Errorf("unexpected properties: %+v", resp.Property)
+	}
+
+	t.Run("missing radius", func(t *testing.T) {
+		for _, radius := range []float64{0, -1} {
+			if _, err := svc.GetPropertiesNearPoint(ctx, 39.7392, -104.9903, radius); !errors.Is(err, ErrMissingParameter) {
+				t.Errorf("radius %v: expected ErrMissingParameter, got %v", radius, err)
+			}
+		}
+	})
+
+	t.Run("invalid coordinates", func(t *testing.T) {
+		if _, err := svc.GetPropertiesNearPoint(ctx, 91, 0, 1); err == nil {
+			t.Errorf("expected error for latitude 91")
+		}
+	})
+}
+
+func TestWithSnapshotFields(t *testing.T) {
+	vals := url.Values{}
+	WithSnapshotFields(" identifier ", "", "location")(vals)
+	if got := vals.Get("fields"); got != "identifier,location" {
+		t.Errorf("expected 'identifier,location', got %q", got)
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	vals := url.Values{}
+	WithFields("identifier", " address ", "", "avm")(vals)
+	if got := vals.Get("fields"); got != "identifier,address,avm" {
+		t.Errorf("expected 'identifier,address,avm', got %q", got)
+	}
+
+	for _, fields := range [][]string{nil, {" ", ""}} {
+		vals := url.Values{}
+		WithFields(fields...)(vals)
+		if vals.Has("fields") {
+			t.Errorf("expected no fields parameter for %q, got %q", fields, vals.Get("fields"))
+		}
+	}
+}
+
+func TestGetPreforeclosureDetailsFullRecord(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/property/v3/preforeclosuredetails",
+		expectedQuery:  url.Values{"attomid": {"184713191"}},
+		responseBody: `{"status":{"code":0,"msg":"SuccessWithResult"},"preforeclosureDetail":[{
+			"propertyId":"184713191",
+			"foreclosureId":"FC-1",
+			"foreclosureType":"NTS",
+			"status":"Active",
+			"filingDate":"2024-01-10",
+			"recordingDate":"2024-01-12",
+			"documentType":"Notice of Trustee Sale",
+			"documentNumber":"2024-000123",
+			"caseNumber":"CV-24-55",
+			"amount":"312500.50",
+			"defaultAmount":18250.75,
+			"defaultDate":"2023-09-01",
+			"originalLoanAmount":350000,
+			"loanBalance":"298000",
+			"lenderName":"First Bank",
+			"borrowerName":"Jane Doe",
+			"trusteeName":"Quality Loan Service",
+			"trusteeAddress":"2763 Camino Del Rio S, San Diego, CA",
+			"trusteePhone":"619-645-7711",
+			"auctionDate":"2024-03-15",
+			"auctionTime":"10:00",
+			"auctionLocation":"County Courthouse Steps",
+			"openingBid":"305000"
+		}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	resp, err := svc.GetPreforeclosureDetails(context.Background(), "184713191")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.PreforeclosureDetails) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(resp.PreforeclosureDetails))
+	}
+	d := resp.PreforeclosureDetails[0]
+
+	strs := map[string]*string{
+		"propertyId":      d.PropertyID,
+		"foreclosureId":   d.ForeclosureID,
+		"foreclosureType": d.ForeclosureType,
+		"status":          d.Status,
+		"filingDate":      d.FilingDate,
+		"recordingDate":   d.RecordingDate,
+		"documentType":    d.DocumentType,
+		"documentNumber":  d.DocumentNumber,
+		"caseNumber":      d.CaseNumber,
+		"defaultDate":     d.DefaultDate,
+		"lenderName":      d.LenderName,
+		"borrowerName":    d.BorrowerName,
+		"trusteeName":     d.TrusteeName,
+		"trusteeAddress":  d.TrusteeAddress,
+		"trusteePhone":    d.TrusteePhone,
+		"auctionDate":     d.AuctionDate,
+		"auctionTime":     d.AuctionTime,
+		"auctionLocation": d.AuctionLocation,
+	}
+	for field, v := range strs {
+		if v == nil || *v == "" {
+			t.Errorf("expected %s to decode", field)
+		}
+	}
+
+	nums := []struct {
+		field string
+		got   *FlexFloat
+		want  float64
+	}{
+		{"amount", d.Amount, 312500.50},
+		{"defaultAmount", d.DefaultAmount, 18250.75},
+		{"originalLoanAmount", d.OriginalLoanAmount, 350000},
+		{"loanBalance", d.LoanBalance, 298000},
+		{"openingBid", d.OpeningBid, 305000},
+	}
+	for _, n := range nums {
+		if n.got == nil {
+			t.Errorf("expected %s to decode", n.field)
+			continue
+		}
+		if n.got.Float64() != n.want {
+			t.Errorf("%s: expected %v, got %v", n.field, n.want, n.got.Float64())
+		}
+	}
+}
+
+func TestGetPropertyDetailXML(t *testing.T) {
+	const jsonBody = `{"status":{"code":0,"total":1,"transactionId":"txn-1"},"property":[{
+		"identifier":{"attomId":"100","fips":"06037"},
+		"address":{"line1":"1 Main St","postalCode":"90001"},
+		"location":{"lat":34.05,"lon":-118.25},
+		"building":{"rooms":{"beds":3,"bathsTotal":"2.5"}},
+		"sale":{"amount":450000},
+		"mortgage":[{"lenderName":"First Bank","interestRate":"6.5%"},{"lenderName":"Second Bank"}],
+		"tax":{"taxYear":2024,"delinquent":"N"}}]}`
+	const xmlBody = `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+	<status><code>0</code><total>1</total><transactionId>txn-1</transactionId></status>
+	<property>
+		<identifier><attomId>100</attomId><fips>06037</fips></identifier>
+		<address><line1>1 Main St</line1><postalCode>90001</postalCode></address>
+		<location><lat>34.05</lat><lon>-118.25</lon></location>
+		<building><rooms><beds>3</beds><bathsTotal>2.5</bathsTotal></rooms></building>
+		<sale><amount>450000</amount></sale>
+		<mortgage><lenderName>First Bank</lenderName><interestRate>6.5%</interestRate></mortgage>
+		<mortgage><lenderName>Second Bank</lenderName></mortgage>
+		<tax><taxYear>2024</taxYear><delinquent>N</delinquent></tax>
+	</property>
+</Response>`
+
+	fetch := func(body, contentType string, opts ...ServiceOption) *DetailResponse {
+		t.Helper()
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			want := AcceptHeaderJSON
+			if contentType == AcceptHeaderXML {
+				want = AcceptHeaderXML
+			}
+			if got := req.Header.Get("Accept"); got != want {
+				t.Errorf("expected Accept %q, got %q", want, got)
+			}
+			header := make(http.Header)
+			header.Set("Content-Type", contentType)
+			return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body))}, nil
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")), opts...)
+		resp, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp
+	}
+
+	fromJSON := fetch(jsonBody, "application/json; charset=utf-8")
+	fromXML := fetch(xmlBody, AcceptHeaderXML, WithAcceptFormat(AcceptHeaderXML))
+	if !reflect.DeepEqual(fromJSON, fromXML) {
+		t.Errorf("XML decoding differs from JSON:\njson: %+v\nxml:  %+v", fromJSON.Property[0], fromXML.Property[0])
+	}
+	if got := fromXML.Status.GetTransactionID(); got != "txn-1" {
+		t.Errorf("expected XML transactionId txn-1, got %q", got)
+	}
+	if len(fromXML.Property) != 1 || len(fromXML.Property[0].Mortgage) != 2 {
+		t.Fatalf("unexpected XML result: %+v", fromXML)
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		svc := NewService(client.New("test-key", httpClientFunc(func(*http.Request) (*http.Response, error) {
+			t.Fatal("request should not be sent")
+			return nil, nil
+		})), WithAcceptFormat("text/csv"))
+		if _, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100")); err == nil {
+			t.Error("expected error for unsupported accept format")
+		}
+	})
+}
@@ -2,8 +2,14 @@ package property
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestSalesEndpoints(t *testing.T) {
@@ -171,6 +177,75 @@ func TestSalesEndpoints(t *testing.T) {
 				return svc.GetTransactionSalesTrend(ctx)
 			},
 		},
+		{
+			name:          "GetSalesTrendSnapshot_AggregationLevel",
+			expectedPath:  "/v4/transaction/snapshot",
+			expectedQuery: url.Values{"geoIdV4": {"geo-1"}, "aggregationLevel": {"zip"}},
+			responseBody:  `{"status":{},"salesTrend":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetSalesTrendSnapshot(ctx, WithGeoIDV4("geo-1"), WithAggregationLevel(AggregationLevelZip))
+			},
+		},
+		{
+			name:                  "GetSalesTrendSnapshot_Error_InvalidAggregationLevel",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "invalid aggregation level",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetSalesTrendSnapshot(ctx, WithGeoIDV4("geo-1"), WithAggregationLevel("planet"))
+			},
+		},
+		{
+			name:          "GetTransactionSalesTrend_AggregationLevel",
+			expectedPath:  "/v4/transaction/salestrend",
+			expectedQuery: url.Values{"geoIdV4": {"geo-1"}, "aggregationLevel": {"county"}},
+			responseBody:  `{"status":{},"transactionTrend":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetTransactionSalesTrend(ctx, WithGeoIDV4("geo-1"), WithAggregationLevel(AggregationLevelCounty))
+			},
+		},
+		{
+			name:                  "GetTransactionSalesTrend_Error_InvalidAggregationLevel",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "invalid aggregation level",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetTransactionSalesTrend(ctx, WithGeoIDV4("geo-1"), WithAggregationLevel("state"))
+			},
+		},
+		{
+			name:          "GetSalesTrendSnapshot_TrendInterval",
+			expectedPath:  "/v4/transaction/snapshot",
+			expectedQuery: url.Values{"geoIdV4": {"geo-1"}, "interval": {"quarterly"}},
+			responseBody:  `{"status":{},"salesTrend":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetSalesTrendSnapshot(ctx, WithGeoIDV4("geo-1"), WithTrendInterval(TrendIntervalQuarterly))
+			},
+		},
+		{
+			name:          "GetTransactionSalesTrend_TrendInterval",
+			expectedPath:  "/v4/transaction/salestrend",
+			expectedQuery: url.Values{"geoIdV4": {"geo-1"}, "interval": {"yearly"}},
+			responseBody:  `{"status":{},"transactionTrend":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetTransactionSalesTrend(ctx, WithGeoIDV4("geo-1"), WithTrendInterval(TrendIntervalYearly))
+			},
+		},
+		{
+			name:                  "GetTransactionSalesTrend_Error_InvalidTrendInterval",
+			expectedPath:          "",
+			expectedQuery:         url.Values{},
+			responseBody:          "",
+			expectError:           true,
+			expectedErrorContains: "invalid trend interval",
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetTransactionSalesTrend(ctx, WithGeoIDV4("geo-1"), WithTrendInterval("weekly"))
+			},
+		},
 		{
 			name:          "GetAllEventsDetail",
 			expectedPath:  "/propertyapi/v1.0.0/allevents/detail",
@@ -280,6 +355,15 @@ func TestSalesEndpoints(t *testing.T) {
 				return svc.GetTransportationNoise(ctx, "100")
 			},
 		},
+		{
+			name:          "GetTransportationNoise_WithOptions",
+			expectedPath:  "/propertyapi/v1.0.0/transportationnoise",
+			expectedQuery: url.Values{"attomid": {"100"}, "page": {"2"}},
+			responseBody:  `{"status":{},"transportationNoise":[{}]}`,
+			call: func(ctx context.Context, svc *Service) (interface{}, error) {
+				return svc.GetTransportationNoise(ctx, "100", WithPage(2))
+			},
+		},
 		{
 			name:                  "GetTransportationNoise_Error_NoAttomID",
 			expectedPath:          "",
@@ -297,3 +381,229 @@ func TestSalesEndpoints(t *testing.T) {
 		runServiceTest(ctx, t, tt)
 	}
 }
+
+func TestGetSaleDetailDecodesFinancing(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/transaction/detail",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		responseBody: `{
+			"status":{"code":0},
+			"sale":[
+				{
+					"saleDate":"2021-06-15",
+					"amount":450000,
+					"buyerName":"JANE DOE",
+					"mortgage":{"lenderName":"FIRST NATIONAL","loanType":"CONVENTIONAL","loanAmount":360000,"interestRate":"3.125%"}
+				},
+				{"saleDate":"2015-02-01","amount":300000}
+			]
+		}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetSaleDetail(context.Background(), WithAttomID("100"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Sale) != 2 {
+		t.Fatalf("expected 2 sales, got %d", len(resp.Sale))
+	}
+	financing := resp.Sale[0].Financing
+	if financing == nil {
+		t.Fatalf("expected financing on financed sale")
+	}
+	if *financing.LenderName != "FIRST NATIONAL" || *financing.LoanAmount != 360000 {
+		t.Errorf("unexpected financing: %+v", financing)
+	}
+	if financing.InterestRate == nil || financing.InterestRate.Float64() != 3.125 {
+		t.Errorf("expected interest rate 3.125, got %v", financing.InterestRate)
+	}
+	if resp.Sale[1].Financing != nil {
+		t.Errorf("expected cash sale to have no financing")
+	}
+}
+
+func TestGetPropertyWithComparables(t *testing.T) {
+	const (
+		detailBody = `{"status":{},"property":[{"identifier":{"attomId":"100"}}]}`
+		compsBody  = `{"status":{},"saleComparable":[{"propertyId":"200"},{"propertyId":"300"}]}`
+		errorBody  = `{"status":{"msg":"server error"}}`
+	)
+	newService := func(t *testing.T, detailCode, compsCode int) *Service {
+		mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			code, body := http.StatusOK, ""
+			switch req.URL.Path {
+			case "/v4/property/detail":
+				if got := req.URL.Query().Get("attomid"); got != "100" {
+					t.Errorf("detail: expected attomid 100, got %q", got)
+				}
+				if req.URL.Query().Get("radius") != "" {
+					t.Errorf("detail: options should not apply to the subject request")
+				}
+				code, body = detailCode, detailBody
+			case "/property/v2/salescomparables/propid/100":
+				if got := req.URL.Query().Get("radius"); got != "1" {
+					t.Errorf("comparables: expected radius 1, got %q", got)
+				}
+				code, body = compsCode, compsBody
+			default:
+				t.Errorf("unexpected path %s", req.URL.Path)
+			}
+			if code != http.StatusOK {
+				body = errorBody
+			}
+			return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+		})
+		return NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	}
+	ctx := context.Background()
+
+	t.Run("both succeed", func(t *testing.T) {
+		prop, comps, err := newService(t, http.StatusOK, http.StatusOK).GetPropertyWithComparables(ctx, "100", WithRadius(1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if prop == nil || prop.Identifier == nil || *prop.Identifier.AttomID != "100" {
+			t.Errorf("expected subject property 100, got %+v", prop)
+		}
+		if len(comps) != 2 {
+			t.Errorf("expected 2 comparables, got %d", len(comps))
+		}
+	})
+
+	t.Run("comparables fail", func(t *testing.T) {
+		prop, comps, err := newService(t, http.StatusOK, http.StatusInternalServerError).GetPropertyWithComparables(ctx, "100", WithRadius(1))
+		if err == nil || !strings.Contains(err.Error(), "sale comparables") {
+			t.Fatalf("expected comparables error, got %v", err)
+		}
+		if prop == nil {
+			t.Errorf("expected subject property despite comparables failure")
+		}
+		if comps != nil {
+			t.Errorf("expected no comparables, got %v", comps)
+		}
+	})
+
+	t.Run("both fail", func(t *testing.T) {
+		prop, comps, err := newService(t, http.StatusInternalServerError, http.StatusBadGateway).GetPropertyWithComparables(ctx, "100", WithRadius(1))
+		if prop != nil || comps != nil {
+			t.Errorf("expected no results, got %v, %v", prop, comps)
+		}
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *Error in joined error, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "subject detail") || !strings.Contains(err.Error(), "sale comparables") {
+			t.Errorf("expected both failures in error, got %v", err)
+		}
+	})
+
+	t.Run("missing attomID", func(t *testing.T) {
+		_, _, err := newService(t, http.StatusOK, http.StatusOK).GetPropertyWithComparables(ctx, " ")
+		if !errors.Is(err, ErrMissingParameter) {
+			t.Fatalf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+}
+
+func TestGetSalesHistoryExpandedDecodesParties(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/transaction/expandedhistory",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		responseBody: `{"status":{},"salesHistory":[{
+			"saleDate":"2021-06-15",
+			"saleAmount":425000,
+			"documentType":"Grant Deed",
+			"recordingDate":"2021-06-20",
+			"transactionType":"Resale",
+			"buyerName":"Alex Buyer",
+			"sellerName":"Sam Seller",
+			"titleCompany":"First American Title",
+			"mortgage":[
+				{"lenderName":"Big Bank","loanType":"Conventional","loanAmount":340000,"interestRate":"3.25"},
+				{"lenderName":"Credit Union","loanType":"HELOC","loanAmount":40000}
+			]
+		}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	resp, err := svc.GetSalesHistoryExpanded(context.Background(), WithAttomID("100"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Sales) != 1 {
+		t.Fatalf("expected 1 sale, got %d", len(resp.Sales))
+	}
+	sale := resp.Sales[0]
+	if sale.SaleAmount == nil || *sale.SaleAmount != 425000 {
+		t.Errorf("expected sale amount 425000, got %v", sale.SaleAmount)
+	}
+	if sale.BuyerName == nil || *sale.BuyerName != "Alex Buyer" {
+		t.Errorf("expected buyer name, got %v", sale.BuyerName)
+	}
+	if sale.SellerName == nil || *sale.SellerName != "Sam Seller" {
+		t.Errorf("expected seller name, got %v", sale.SellerName)
+	}
+	if sale.TitleCompany == nil || *sale.TitleCompany != "First American Title" {
+		t.Errorf("expected title company, got %v", sale.TitleCompany)
+	}
+	if sale.TransactionType == nil || *sale.TransactionType != "Resale" {
+		t.Errorf("expected transaction type, got %v", sale.TransactionType)
+	}
+	if len(sale.Financing) != 2 {
+		t.Fatalf("expected 2 loans, got %d", len(sale.Financing))
+	}
+	first := sale.Financing[0]
+	if first.LoanAmount == nil || *first.LoanAmount != 340000 {
+		t.Errorf("expected first loan amount 340000, got %v", first.LoanAmount)
+	}
+	if first.InterestRate == nil || first.InterestRate.Float64() != 3.25 {
+		t.Errorf("expected interest rate 3.25, got %v", first.InterestRate)
+	}
+	if sale.Financing[1].LoanType == nil || *sale.Financing[1].LoanType != "HELOC" {
+		t.Errorf("expected second loan type HELOC, got %v", sale.Financing[1].LoanType)
+	}
+}
+
+func TestGetAllSaleComparablesByPropID(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"status":{"total":3,"page":1,"pagesize":2},"saleComparable":[{"propertyId":"1"},{"propertyId":"2"}]}`,
+		"2": `{"status":{"total":3,"page":2,"pagesize":2},"saleComparable":[{"propertyId":"3"}]}`,
+	}
+	var requested []string
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/property/v2/salescomparables/propid/100" {
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+		page := req.URL.Query().Get("page")
+		requested = append(requested, page)
+		body, ok := pages[page]
+		if !ok {
+			t.Fatalf("unexpected page %q", page)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.GetAllSaleComparablesByPropID(context.Background(), "100", WithPageSize(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.SaleComparables) != 3 {
+		t.Fatalf("expected 3 merged comparables, got %d", len(resp.SaleComparables))
+	}
+	for i, want := range []string{"1", "2", "3"} {
+		if got := resp.SaleComparables[i].PropertyID; got == nil || *got != want {
+			t.Errorf("comparable %d: expected propertyId %s, got %v", i, want, got)
+		}
+	}
+	if resp.Status == nil || resp.Status.Total == nil || *resp.Status.Total != 3 {
+		t.Errorf("expected Status.Total 3, got %+v", resp.Status)
+	}
+	if strings.Join(requested, ",") != "1,2" {
+		t.Errorf("expected pages 1,2 to be requested, got %v", requested)
+	}
+}
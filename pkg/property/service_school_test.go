@@ -2,8 +2,12 @@ package property
 
 import (
 	"context"
+	"math"
+	"net/http"
 	"net/url"
 	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
 )
 
 func TestSchoolEndpoints(t *testing.T) {
@@ -92,3 +96,93 @@ func TestSchoolEndpoints(t *testing.T) {
 		runServiceTest(ctx, t, tt)
 	}
 }
+
+func TestSearchSchoolsNear(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/school/search",
+		expectedQuery:  url.Values{"latitude": {"39.7392"}, "longitude": {"-104.9903"}},
+		responseBody: `{
+			"status":{"code":0,"total":2},
+			"school":[
+				{"schoolId":"s-1","name":"Lincoln Elementary","gradeLow":"K","gradeHigh":"5"},
+				{"schoolId":"s-2","name":"Central High","gradeLow":"9","gradeHigh":"12"}
+			]
+		}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := svc.SearchSchoolsNear(ctx, 39.7392, -104.9903)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.School) != 2 {
+		t.Fatalf("expected 2 schools, got %d", len(resp.School))
+	}
+	if *resp.School[0].Name != "Lincoln Elementary" || *resp.School[1].GradeHigh != "12" {
+		t.Errorf("unexpected schools: %+v, %+v", resp.School[0], resp.School[1])
+	}
+	// The mock rejects any query other than the validated coordinates.
+	if _, err := svc.SearchSchoolsNear(ctx, 39.7392, -104.9903, WithLatitudeLongitude(1, 2)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	invalid := []struct {
+		name     string
+		lat, lon float64
+	}{
+		{name: "latitude too high", lat: 91, lon: 0},
+		{name: "latitude too low", lat: -91, lon: 0},
+		{name: "longitude out of range", lat: 0, lon: 181},
+		{name: "NaN latitude", lat: math.NaN(), lon: 0},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.SearchSchoolsNear(ctx, tt.lat, tt.lon); err == nil {
+				t.Errorf("expected error for coordinates (%v, %v)", tt.lat, tt.lon)
+			}
+		})
+	}
+}
+
+func TestWithDeprecationReporter(t *testing.T) {
+	ctx := context.Background()
+	var reported []string
+	mock := &mockHTTPClient{t: t, responseBody: `{"status":{}}`}
+	svc := NewService(
+		client.New("test-key", mock, client.WithBaseURL("https://example.com/")),
+		WithDeprecationReporter(func(method string) { reported = append(reported, method) }),
+	)
+
+	if _, err := svc.GetSchoolDetail(ctx, "S1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.GetSchoolSnapshot(ctx, "39.7", "-89.6", "5", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.GetSchoolDistrictDetail(ctx, "D1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.GetSchoolDetailWithSchools(ctx, "123 Main St"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"GetSchoolDetail", "GetSchoolSnapshot", "GetSchoolDistrictDetail"}
+	if len(reported) != len(want) {
+		t.Fatalf("expected reports %v, got %v", want, reported)
+	}
+	for i := range want {
+		if reported[i] != want[i] {
+			t.Errorf("report %d: expected %q, got %q", i, want[i], reported[i])
+		}
+	}
+
+	t.Run("no reporter configured", func(t *testing.T) {
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		if _, err := svc.GetSchoolDetail(ctx, "S1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
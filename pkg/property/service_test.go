@@ -1,11 +1,15 @@
 package property
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -39,6 +43,146 @@ func TestServiceErrorResponse(t *testing.T) {
 	if apiErr.StatusCode != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, apiErr.StatusCode)
 	}
+	if apiErr.Endpoint != "v4/property/detail" || apiErr.Query.Get("attomid") != "100" {
+		t.Errorf("expected request details, got endpoint %q query %v", apiErr.Endpoint, apiErr.Query)
+	}
+	if !strings.Contains(err.Error(), "v4/property/detail?attomid=100") {
+		t.Errorf("expected endpoint in error, got %q", err.Error())
+	}
+}
+
+func TestErrorTransactionID(t *testing.T) {
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/detail",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		statusCode:     http.StatusBadRequest,
+		responseBody:   `{"status":{"code":400,"msg":"bad request","transactionId":"txn-42"}}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	_, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	if got := apiErr.Status.GetTransactionID(); got != "txn-42" {
+		t.Errorf("expected transaction ID txn-42, got %q", got)
+	}
+	want := "property: bad request (request v4/property/detail?attomid=100, transaction txn-42)"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+
+	id := "txn-7"
+	e := &Error{Message: "boom", Status: &Status{TransactionID: &id}}
+	if got := e.Error(); got != "property: boom (transaction txn-7)" {
+		t.Errorf("unexpected message %q", got)
+	}
+}
+
+func TestErrorRedactsAPIKey(t *testing.T) {
+	e := &Error{
+		StatusCode: http.StatusBadRequest,
+		Message:    "bad request",
+		Endpoint:   "v4/property/detail",
+		Query:      url.Values{"apikey": {"secret"}, "attomid": {"100"}},
+	}
+	got := e.Error()
+	if strings.Contains(got, "secret") {
+		t.Fatalf("api key leaked in %q", got)
+	}
+	if got != "property: bad request (request v4/property/detail?apikey=REDACTED&attomid=100)" {
+		t.Errorf("unexpected message %q", got)
+	}
+	if redacted := redactQuery(url.Values{"ApiKey": {"secret"}}); redacted.Get("ApiKey") != redactedQueryValue {
+		t.Errorf("expected case-insensitive redaction, got %v", redacted)
+	}
+}
+
+func TestServiceAuthErrors(t *testing.T) {
+	tests := []struct {
+		sentinel   error
+		name       string
+		statusCode int
+	}{
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, sentinel: ErrUnauthorized},
+		{name: "forbidden", statusCode: http.StatusForbidden, sentinel: ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{
+				t:              t,
+				expectedMethod: http.MethodGet,
+				expectedPath:   "/v4/property/detail",
+				expectedQuery:  url.Values{"attomid": {"100"}},
+				statusCode:     tt.statusCode,
+				responseBody:   `{"status":{"msg":"access denied"}}`,
+			}
+			svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+			_, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("expected errors.Is(err, %v), got %v", tt.sentinel, err)
+			}
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.statusCode {
+				t.Fatalf("expected *Error with status %d, got %v", tt.statusCode, err)
+			}
+			if apiErr.Error() != "property: access denied (request v4/property/detail?attomid=100)" {
+				t.Errorf("unexpected message %q", apiErr.Error())
+			}
+		})
+	}
+
+	t.Run("other statuses have no sentinel", func(t *testing.T) {
+		err := error(&Error{StatusCode: http.StatusBadRequest})
+		if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden) || errors.Is(err, ErrRateLimited) {
+			t.Errorf("unexpected sentinel match for %v", err)
+		}
+	})
+}
+
+func TestWithNoResultsError(t *testing.T) {
+	ctx := context.Background()
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/property/detail",
+		expectedQuery:  url.Values{"attomid": {"100"}},
+		responseBody:   `{"status":{"code":1,"msg":"SuccessWithoutResult","total":0},"property":[]}`,
+	}
+	c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+
+	t.Run("disabled by default", func(t *testing.T) {
+		resp, err := NewService(c).GetPropertyDetail(ctx, WithAttomID("100"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Property) != 0 {
+			t.Errorf("expected no properties, got %d", len(resp.Property))
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		svc := NewService(c, WithNoResultsError(true))
+		_, err := svc.GetPropertyDetail(ctx, WithAttomID("100"))
+		if !errors.Is(err, ErrNoResults) {
+			t.Fatalf("expected ErrNoResults, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "SuccessWithoutResult") {
+			t.Errorf("expected status message in error, got %v", err)
+		}
+	})
+
+	t.Run("results are not an error", func(t *testing.T) {
+		mock.responseBody = `{"status":{"code":0,"msg":"SuccessWithResult"},"property":[{"identifier":{"attomId":"100"}}]}`
+		svc := NewService(c, WithNoResultsError(true))
+		if _, err := svc.GetPropertyDetail(ctx, WithAttomID("100")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
 }
 
 func TestErrorTypes(t *testing.T) {
@@ -68,6 +212,397 @@ func TestErrorTypes(t *testing.T) {
 	})
 }
 
+func TestServiceDecodesGzipResponse(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(`{"status":{"code":0},"property":[{"identifier":{"attomId":"100"}}]}`)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		if got := req.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("expected Accept-Encoding gzip, got %q", got)
+		}
+		header := make(http.Header)
+		header.Set("Content-Encoding", "gzip")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader(buf.Bytes())),
+		}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+	resp, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Property) != 1 || resp.Property[0].Identifier == nil || resp.Property[0].Identifier.AttomID == nil || *resp.Property[0].Identifier.AttomID != "100" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestGetRaw(t *testing.T) {
+	mock := httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/v4/property/detail" || req.URL.Query().Get("attomid") != "100" || req.URL.Query().Get("pagesize") != "5" {
+			t.Errorf("unexpected request URL %s", req.URL)
+		}
+		header := make(http.Header)
+		header.Set("X-Transaction-Id", "txn-1")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(`{"property":[]}`)),
+		}, nil
+	})
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")), WithDefaultOptions(WithPageSize(5)))
+	resp, err := svc.GetRaw(context.Background(), "v4/property/detail", []Option{WithAttomID("100")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			t.Errorf("close body: %v", closeErr)
+		}
+	}()
+	if got := resp.Header.Get("X-Transaction-Id"); got != "txn-1" {
+		t.Errorf("expected custom header txn-1, got %q", got)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != `{"property":[]}` {
+		t.Errorf("unexpected body %q (err=%v)", body, err)
+	}
+
+	var nilSvc *Service
+	if _, err := nilSvc.GetRaw(context.Background(), "v4/property/detail", nil); err == nil {
+		t.Error("expected error for nil service")
+	}
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	newService := func(opts ...ServiceOption) *Service {
+		mock := &mockHTTPClient{
+			t:              t,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/v4/property/detail",
+			expectedQuery:  url.Values{"attomid": {"100"}},
+			responseBody:   `{"status":{"code":0},"property":[{"identifier":{"attomId":"100"},"newAttomField":true}]}`,
+			statusCode:     http.StatusOK,
+		}
+		return NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")), opts...)
+	}
+
+	t.Run("lenient by default", func(t *testing.T) {
+		resp, err := newService().GetPropertyDetail(context.Background(), WithAttomID("100"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Property) != 1 {
+			t.Fatalf("expected one property, got %d", len(resp.Property))
+		}
+	})
+
+	t.Run("strict rejects unknown fields", func(t *testing.T) {
+		_, err := newService(WithStrictDecoding(true)).GetPropertyDetail(context.Background(), WithAttomID("100"))
+		if err == nil {
+			t.Fatal("expected error for unknown field")
+		}
+		if !strings.Contains(err.Error(), "v4/property/detail") || !strings.Contains(err.Error(), "newAttomField") {
+			t.Errorf("expected error naming endpoint and field, got %v", err)
+		}
+	})
+
+	nested := []struct {
+		name     string
+		property string
+	}{
+		{name: "lot", property: `{"lot":{"acres":0.25,"newAttomField":1}}`},
+		{name: "assessment", property: `{"assessment":{"assdTtlValue":"125000","newAttomField":1}}`},
+		{name: "avm", property: `{"avm":{"score":"87","newAttomField":1}}`},
+		{name: "rooms", property: `{"building":{"rooms":{"beds":3,"newAttomField":1}}}`},
+		{name: "sale", property: `{"sale":{"amount":{"saleamt":250000},"newAttomField":1}}`},
+	}
+	for _, tt := range nested {
+		t.Run("strict rejects unknown field in "+tt.name, func(t *testing.T) {
+			newNestedService := func(opts ...ServiceOption) *Service {
+				mock := &mockHTTPClient{
+					t:              t,
+					expectedMethod: http.MethodGet,
+					expectedPath:   "/v4/property/detail",
+					expectedQuery:  url.Values{"attomid": {"100"}},
+					responseBody:   `{"status":{"code":0},"property":[` + tt.property + `]}`,
+					statusCode:     http.StatusOK,
+				}
+				return NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")), opts...)
+			}
+			if _, err := newNestedService().GetPropertyDetail(context.Background(), WithAttomID("100")); err != nil {
+				t.Fatalf("unexpected error in lenient mode: %v", err)
+			}
+			_, err := newNestedService(WithStrictDecoding(true)).GetPropertyDetail(context.Background(), WithAttomID("100"))
+			if err == nil {
+				t.Fatal("expected error for nested unknown field")
+			}
+			if !strings.Contains(err.Error(), "newAttomField") {
+				t.Errorf("expected error naming the field, got %v", err)
+			}
+		})
+	}
+}
+
+func TestErrorRetryAfter(t *testing.T) {
+	rateLimited := func(retryAfter string) *Error {
+		t.Helper()
+		mock := httpClientFunc(func(_ *http.Request) (*http.Response, error) {
+			header := make(http.Header)
+			if retryAfter != "" {
+				header.Set("Retry-After", retryAfter)
+			}
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"status":{"msg":"rate limited"}}`)),
+			}, nil
+		})
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		_, err := svc.GetPropertyDetail(context.Background(), WithAttomID("100"))
+		var apiErr *Error
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *Error, got %v", err)
+		}
+		return apiErr
+	}
+
+	t.Run("seconds", func(t *testing.T) {
+		got, ok := rateLimited("7").RetryAfter()
+		if !ok || got != 7*time.Second {
+			t.Errorf("expected 7s, got %v (ok=%v)", got, ok)
+		}
+	})
+
+	t.Run("http date", func(t *testing.T) {
+		at := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+		got, ok := rateLimited(at).RetryAfter()
+		if !ok || got <= 80*time.Second || got > 90*time.Second {
+			t.Errorf("expected roughly 90s, got %v (ok=%v)", got, ok)
+		}
+	})
+
+	t.Run("matches ErrRateLimited", func(t *testing.T) {
+		apiErr := rateLimited("7")
+		if !errors.Is(apiErr, ErrRateLimited) {
+			t.Errorf("expected errors.Is(err, ErrRateLimited), got %v", apiErr)
+		}
+		if apiErr.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected status 429, got %d", apiErr.StatusCode)
+		}
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		if got, ok := rateLimited("").RetryAfter(); ok {
+			t.Errorf("expected no delay, got %v", got)
+		}
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		var e *Error
+		if _, ok := e.RetryAfter(); ok {
+			t.Errorf("expected ok=false for nil error")
+		}
+	})
+}
+
+func TestGenericGet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("decodes response", func(t *testing.T) {
+		mock := &mockHTTPClient{
+			t:              t,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/v4/property/detail",
+			expectedQuery:  url.Values{"attomid": {"100"}},
+			responseBody:   `{"status":{"total":1},"property":[{"identifier":{"attomId":"100"}}]}`,
+		}
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		resp, err := get[DetailResponse](ctx, svc, propertyBasePath+"detail", []Option{WithAttomID("100")}, requirePropertyIdentifier)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Status == nil || resp.Status.Total == nil || *resp.Status.Total != 1 || len(resp.Property) != 1 {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		svc := NewService(client.New("test-key", nil, client.WithBaseURL("https://example.com/")))
+		resp, err := get[DetailResponse](ctx, svc, propertyBasePath+"detail", nil, requirePropertyIdentifier)
+		if !errors.Is(err, ErrMissingParameter) || resp != nil {
+			t.Fatalf("expected ErrMissingParameter and nil response, got %v, %v", resp, err)
+		}
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		mock := &mockHTTPClient{t: t, statusCode: http.StatusNotFound, responseBody: `{"status":{"msg":"not found"}}`}
+		svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+		resp, err := get[DetailResponse](ctx, svc, propertyBasePath+"detail", []Option{WithAttomID("100")}, nil)
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || resp != nil {
+			t.Fatalf("expected *Error and nil response, got %v, %v", resp, err)
+		}
+	})
+}
+
+// TestGenericGetRefactoredMethods checks that methods built on get[T] return
+// exactly what Service.get decodes for the same request.
+func TestExportedGet(t *testing.T) {
+	type marketStats struct {
+		Status *Status `json:"status"`
+		Stats  []struct {
+			Median float64 `json:"median"`
+			Label  string  `json:"label"`
+		} `json:"stats"`
+	}
+	mock := &mockHTTPClient{
+		t:              t,
+		expectedMethod: http.MethodGet,
+		expectedPath:   "/v4/market/stats",
+		expectedQuery:  url.Values{"geoIdV4": {"geo-1"}},
+		responseBody:   `{"status":{"code":0},"stats":[{"median":512000.5,"label":"2024"}]}`,
+	}
+	svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+	resp, err := Get[marketStats](context.Background(), svc, "v4/market/stats", WithGeoIDV4("geo-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Stats) != 1 || resp.Stats[0].Median != 512000.5 || resp.Stats[0].Label != "2024" {
+		t.Errorf("unexpected decoded response: %+v", resp)
+	}
+
+	failing := &mockHTTPClient{t: t, statusCode: http.StatusBadRequest, responseBody: `{"status":{"msg":"bad"}}`}
+	svc = NewService(client.New("test-key", failing, client.WithBaseURL("https://example.com/")))
+	var apiErr *Error
+	if _, err := Get[marketStats](context.Background(), svc, "v4/market/stats"); !errors.As(err, &apiErr) {
+		t.Errorf("expected *Error, got %v", err)
+	}
+}
+
+func TestGenericGetRefactoredMethods(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		endpoint string
+		query    url.Values
+		body     string
+		call     func(*Service) (interface{}, error)
+		direct   func(*Service, []Option) (interface{}, error)
+		invalid  func(*Service) error
+		wantErr  string
+	}{
+		{
+			name:     "GetSchoolDetail",
+			endpoint: schoolBasePath + "detail",
+			query:    url.Values{"id": {"S1"}},
+			body:     `{"status":{},"school":[{"name":"Lincoln"}]}`,
+			call:     func(s *Service) (interface{}, error) { return s.GetSchoolDetail(ctx, "S1") },
+			direct: func(s *Service, opts []Option) (interface{}, error) {
+				var r SchoolDetailResponse
+				return &r, s.get(ctx, schoolBasePath+"detail", opts, nil, &r)
+			},
+			invalid: func(s *Service) error { _, err := s.GetSchoolDetail(ctx, ""); return err },
+			wantErr: "school id required",
+		},
+		{
+			name:     "GetSchoolDistrictDetail",
+			endpoint: schoolBasePath + "districtdetail",
+			query:    url.Values{"id": {"D1"}},
+			body:     `{"status":{},"district":[{"name":"Unified"}]}`,
+			call:     func(s *Service) (interface{}, error) { return s.GetSchoolDistrictDetail(ctx, "D1") },
+			direct: func(s *Service, opts []Option) (interface{}, error) {
+				var r SchoolDistrictDetailResponse
+				return &r, s.get(ctx, schoolBasePath+"districtdetail", opts, nil, &r)
+			},
+			invalid: func(s *Service) error { _, err := s.GetSchoolDistrictDetail(ctx, ""); return err },
+			wantErr: "district id required",
+		},
+		{
+			name:     "GetHomeEquity",
+			endpoint: valuationBasePath + "homeequity",
+			query:    url.Values{"address1": {"1 Main St"}, "address2": {"Springfield, IL"}},
+			body:     `{"status":{},"homeEquity":125000.5}`,
+			call:     func(s *Service) (interface{}, error) { return s.GetHomeEquity(ctx, "1 Main St", "Springfield, IL") },
+			direct: func(s *Service, opts []Option) (interface{}, error) {
+				var r HomeEquityResponse
+				return &r, s.get(ctx, valuationBasePath+"homeequity", opts, nil, &r)
+			},
+			invalid: func(s *Service) error { _, err := s.GetHomeEquity(ctx, "1 Main St", ""); return err },
+			wantErr: "address1 and address2 required",
+		},
+		{
+			name:     "GetHomeEquityByAttomID",
+			endpoint: valuationBasePath + "homeequity",
+			query:    url.Values{"attomid": {"100"}},
+			body:     `{"status":{},"homeEquity":99}`,
+			call:     func(s *Service) (interface{}, error) { return s.GetHomeEquityByAttomID(ctx, " 100 ") },
+			direct: func(s *Service, opts []Option) (interface{}, error) {
+				var r HomeEquityResponse
+				return &r, s.get(ctx, valuationBasePath+"homeequity", opts, nil, &r)
+			},
+			invalid: func(s *Service) error { _, err := s.GetHomeEquityByAttomID(ctx, " "); return err },
+			wantErr: "attomid required",
+		},
+		{
+			name:     "GetAVMHistoryByAddress",
+			endpoint: avmHistoryBasePath + "detail",
+			query:    url.Values{"address1": {"1 Main St"}, "address2": {"Springfield, IL"}},
+			body:     `{"status":{},"avmHistory":[{"date":"2024-01-01","value":300000}]}`,
+			call: func(s *Service) (interface{}, error) {
+				return s.GetAVMHistoryByAddress(ctx, "1 Main St", "Springfield, IL")
+			},
+			direct: func(s *Service, opts []Option) (interface{}, error) {
+				var r AVMHistoryResponse
+				return &r, s.get(ctx, avmHistoryBasePath+"detail", opts, nil, &r)
+			},
+			invalid: func(s *Service) error { _, err := s.GetAVMHistoryByAddress(ctx, "", "Springfield, IL"); return err },
+			wantErr: "address1 and address2 required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{
+				t:              t,
+				expectedMethod: http.MethodGet,
+				expectedPath:   "/" + tt.endpoint,
+				expectedQuery:  tt.query,
+				responseBody:   tt.body,
+			}
+			svc := NewService(client.New("test-key", mock, client.WithBaseURL("https://example.com/")))
+
+			got, err := tt.call(svc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			opts := make([]Option, 0, len(tt.query))
+			for k := range tt.query {
+				opts = append(opts, WithString(k, tt.query.Get(k)))
+			}
+			want, err := tt.direct(svc, opts)
+			if err != nil {
+				t.Fatalf("unexpected error from Service.get: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("response mismatch:\n got  %+v\n want %+v", got, want)
+			}
+
+			err = tt.invalid(svc)
+			if !errors.Is(err, ErrMissingParameter) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected validation error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestNewService(t *testing.T) {
 	t.Run("nil client", func(t *testing.T) {
 		svc := NewService(nil)
@@ -85,6 +620,55 @@ func TestNewService(t *testing.T) {
 	})
 }
 
+func TestWithDefaultOptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults apply", func(t *testing.T) {
+		mock := &mockHTTPClient{
+			t:              t,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/v4/property/snapshot",
+			expectedQuery:  url.Values{"postalCode": {"62701"}, "pagesize": {"50"}, "propertytype": {"SFR"}},
+			responseBody:   `{"status":{},"property":[]}`,
+		}
+		c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+		svc := NewService(c, WithDefaultOptions(WithPageSize(50), WithPropertyType("SFR")))
+		if _, err := svc.GetPropertySnapshot(ctx, WithPostalCode("62701")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("per-call options override defaults", func(t *testing.T) {
+		mock := &mockHTTPClient{
+			t:              t,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/v4/property/snapshot",
+			expectedQuery:  url.Values{"postalCode": {"62701"}, "pagesize": {"10"}, "propertytype": {"CONDOMINIUM"}},
+			responseBody:   `{"status":{},"property":[]}`,
+		}
+		c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+		svc := NewService(c, WithDefaultOptions(WithPageSize(50), WithPropertyType("SFR")))
+		_, err := svc.GetPropertySnapshot(ctx, WithPostalCode("62701"), WithPageSize(10), WithPropertyType("CONDOMINIUM"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("defaults satisfy validation", func(t *testing.T) {
+		mock := &mockHTTPClient{
+			t:             t,
+			expectedPath:  "/v4/property/detail",
+			expectedQuery: url.Values{"attomid": {"100"}},
+			responseBody:  `{"status":{},"property":[]}`,
+		}
+		c := client.New("test-key", mock, client.WithBaseURL("https://example.com/"))
+		svc := NewService(c, WithDefaultOptions(WithAttomID("100")))
+		if _, err := svc.GetPropertyDetail(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
 func TestEnsureClient(t *testing.T) {
 	t.Run("nil service", func(t *testing.T) {
 		var svc *Service
@@ -149,6 +733,57 @@ func TestWithPropertyID(t *testing.T) {
 	}
 }
 
+func TestWithPropertyTypes(t *testing.T) {
+	vals := url.Values{}
+	WithPropertyTypes(PropertyTypeCondominium, " duplex ", PropertyTypeApartment)(vals)
+	want := PropertyTypeCondominium + "|" + PropertyTypeDuplex + "|" + PropertyTypeApartment
+	if got := vals.Get("propertytype"); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if err := validatePropertyTypeParam(vals); err != nil {
+		t.Errorf("joined value should pass validation, got %v", err)
+	}
+
+	vals = url.Values{"propertytype": {PropertyTypeCabin}}
+	WithPropertyTypes(PropertyTypeCondominium, "CASTLE")(vals)
+	if got := vals.Get("propertytype"); got != PropertyTypeCabin {
+		t.Errorf("expected invalid list to be a no-op, got %q", got)
+	}
+
+	vals = url.Values{}
+	WithPropertyTypes()(vals)
+	if vals.Has("propertytype") {
+		t.Errorf("expected no propertytype for empty list, got %q", vals.Get("propertytype"))
+	}
+}
+
+func TestWithBoundingBoxWKT(t *testing.T) {
+	vals := url.Values{}
+	WithBoundingBoxWKT(39.5, -105.25, 40, -104.5)(vals)
+	want := "POLYGON((-105.25 39.5, -104.5 39.5, -104.5 40, -105.25 40, -105.25 39.5))"
+	if got := vals.Get("WKTString"); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	invalid := []struct {
+		name                           string
+		minLat, minLon, maxLat, maxLon float64
+	}{
+		{"inverted latitude", 40, -105, 39, -104},
+		{"inverted longitude", 39, -104, 40, -105},
+		{"empty box", 39, -105, 39, -104},
+		{"latitude out of range", 80, -105, 91, -104},
+		{"longitude out of range", 39, -181, 40, -104},
+	}
+	for _, tt := range invalid {
+		vals := url.Values{}
+		WithBoundingBoxWKT(tt.minLat, tt.minLon, tt.maxLat, tt.maxLon)(vals)
+		if vals.Has("WKTString") {
+			t.Errorf("%s: expected no-op, got %q", tt.name, vals.Get("WKTString"))
+		}
+	}
+}
+
 func TestWithFIPSAndAPN(t *testing.T) {
 	vals := url.Values{}
 	WithFIPSAndAPN("001", "456")(vals)
@@ -182,6 +817,39 @@ func TestWithLatitudeLongitude(t *testing.T) {
 	}
 }
 
+func TestCombineOptions(t *testing.T) {
+	tenant := []Option{WithOrderBy("beds"), WithPageSize(50)}
+	request := []Option{WithOrderBy("salesearchdate desc")}
+
+	combined := CombineOptions(tenant, nil, []Option{}, request)
+	if len(combined) != 3 {
+		t.Fatalf("expected 3 options, got %d", len(combined))
+	}
+	vals := applyOptions(combined)
+	if got := vals.Get("orderby"); got != "salesearchdate desc" {
+		t.Errorf("expected later group to override orderby, got %q", got)
+	}
+	if got := vals.Get("pagesize"); got != "50" {
+		t.Errorf("expected pagesize from earlier group, got %q", got)
+	}
+
+	if got := applyOptions(CombineOptions(tenant, nil)); !reflect.DeepEqual(got, applyOptions(tenant)) {
+		t.Errorf("expected empty group to be a no-op, got %v", got)
+	}
+	if got := CombineOptions(); len(got) != 0 {
+		t.Errorf("expected no options, got %d", len(got))
+	}
+}
+
+func TestWithOptions(t *testing.T) {
+	bundle := WithOptions(WithOrderBy("beds"), nil, WithPageSize(50))
+	vals := applyOptions([]Option{bundle, WithOrderBy("bathstotal")})
+	want := url.Values{"orderby": {"bathstotal"}, "pagesize": {"50"}}
+	if !reflect.DeepEqual(vals, want) {
+		t.Errorf("expected %v, got %v", want, vals)
+	}
+}
+
 func TestWithRadius(t *testing.T) {
 	t.Run("valid radius", func(t *testing.T) {
 		vals := url.Values{}
@@ -192,6 +860,22 @@ func TestWithRadius(t *testing.T) {
 	})
 }
 
+func TestWithRadiusKilometers(t *testing.T) {
+	vals := url.Values{}
+	WithRadiusKilometers(16.09344)(vals)
+	if vals.Get("radius") != "10" {
+		t.Errorf("expected '10', got %q", vals.Get("radius"))
+	}
+
+	for _, km := range []float64{0, -5} {
+		vals := url.Values{}
+		WithRadiusKilometers(km)(vals)
+		if vals.Has("radius") {
+			t.Errorf("expected no radius for %v km, got %q", km, vals.Get("radius"))
+		}
+	}
+}
+
 func TestWithBathsRange(t *testing.T) {
 	vals := url.Values{}
 	WithBathsRange(1.5, 3.0)(vals)
@@ -203,6 +887,29 @@ func TestWithBathsRange(t *testing.T) {
 	}
 }
 
+func TestWithBedsAndBaths(t *testing.T) {
+	vals := url.Values{}
+	WithBeds(3)(vals)
+	WithBaths(2.5)(vals)
+	want := url.Values{
+		"minBeds":       {"3"},
+		"maxBeds":       {"3"},
+		"minBathsTotal": {"2.5"},
+		"maxBathsTotal": {"2.5"},
+	}
+	if !reflect.DeepEqual(vals, want) {
+		t.Errorf("expected %v, got %v", want, vals)
+	}
+
+	for _, opt := range []Option{WithBeds(0), WithBeds(-1), WithBaths(0), WithBaths(-1.5)} {
+		vals := url.Values{}
+		opt(vals)
+		if len(vals) != 0 {
+			t.Errorf("expected no-op, got %v", vals)
+		}
+	}
+}
+
 func TestWithSaleAmountRange(t *testing.T) {
 	vals := url.Values{}
 	WithSaleAmountRange(100000, 500000)(vals)
@@ -214,6 +921,83 @@ func TestWithSaleAmountRange(t *testing.T) {
 	}
 }
 
+func TestWithTaxAmountRange(t *testing.T) {
+	vals := url.Values{}
+	WithTaxAmountRange(2500.5, 8000)(vals)
+	if vals.Get("minTaxAmt") != "2500.5" {
+		t.Errorf("expected '2500.5', got %q", vals.Get("minTaxAmt"))
+	}
+	if vals.Get("maxTaxAmt") != "8000" {
+		t.Errorf("expected '8000', got %q", vals.Get("maxTaxAmt"))
+	}
+
+	vals = url.Values{}
+	WithTaxAmountRange(0, 0)(vals)
+	if len(vals) != 0 {
+		t.Errorf("expected zero bounds to be omitted, got %v", vals)
+	}
+}
+
+func TestWithAssessedValueRange(t *testing.T) {
+	vals := url.Values{}
+	WithAssessedValueRange(27700, 483600)(vals)
+	if vals.Get("minAssdTtlValue") != "27700" {
+		t.Errorf("expected '27700', got %q", vals.Get("minAssdTtlValue"))
+	}
+	if vals.Get("maxAssdTtlValue") != "483600" {
+		t.Errorf("expected '483600', got %q", vals.Get("maxAssdTtlValue"))
+	}
+
+	vals = url.Values{}
+	WithAssessedValueRange(0, 0)(vals)
+	if len(vals) != 0 {
+		t.Errorf("expected zero bounds to be omitted, got %v", vals)
+	}
+}
+
+func TestWithAVMConfidenceRange(t *testing.T) {
+	vals := url.Values{}
+	WithAVMConfidenceRange(70, 99.5)(vals)
+	if vals.Get("minConfidence") != "70" {
+		t.Errorf("expected '70', got %q", vals.Get("minConfidence"))
+	}
+	if vals.Get("maxConfidence") != "99.5" {
+		t.Errorf("expected '99.5', got %q", vals.Get("maxConfidence"))
+	}
+
+	vals = url.Values{}
+	WithAVMConfidenceRange(0, -5)(vals)
+	if len(vals) != 0 {
+		t.Errorf("expected zero and negative bounds to be omitted, got %v", vals)
+	}
+
+	vals = url.Values{}
+	WithAVMConfidenceRange(80, 120)(vals)
+	if vals.Get("minConfidence") != "80" || vals.Has("maxConfidence") {
+		t.Errorf("expected out-of-range max to be omitted, got %v", vals)
+	}
+}
+
+func TestWithSort(t *testing.T) {
+	vals := url.Values{}
+	WithSort(OrderBySaleAmount, false)(vals)
+	if got := vals.Get("orderby"); got != "saleamt asc" {
+		t.Errorf("expected 'saleamt asc', got %q", got)
+	}
+
+	vals = url.Values{}
+	WithSort(OrderByPublishedDate, true)(vals)
+	if got := vals.Get("orderby"); got != "publisheddate desc" {
+		t.Errorf("expected 'publisheddate desc', got %q", got)
+	}
+
+	vals = url.Values{}
+	WithSort("price", true)(vals)
+	if vals.Has("orderby") {
+		t.Errorf("expected invalid field to be rejected, got %q", vals.Get("orderby"))
+	}
+}
+
 func TestWithUniversalSizeRange(t *testing.T) {
 	vals := url.Values{}
 	WithUniversalSizeRange(1000, 3000)(vals)
@@ -294,6 +1078,56 @@ func TestWithISODateRange(t *testing.T) {
 	}
 }
 
+func TestNamedDateRangeOptions(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		opt  Option
+		want url.Values
+	}{
+		{
+			name: "sale",
+			opt:  WithSaleDateRange(start, end),
+			want: url.Values{"startSaleSearchDate": {"2018/01/01"}, "endSaleSearchDate": {"2019/12/31"}},
+		},
+		{
+			name: "recording",
+			opt:  WithRecordingDateRange(start, end),
+			want: url.Values{"startSaleTransDate": {"2018/01/01"}, "endSaleTransDate": {"2019/12/31"}},
+		},
+		{
+			name: "sale open ended",
+			opt:  WithSaleDateRange(start, time.Time{}),
+			want: url.Values{"startSaleSearchDate": {"2018/01/01"}},
+		},
+		{
+			name: "assessment years",
+			opt:  WithAssessmentYearRange(2018, 2019),
+			want: url.Values{"startCalendarDate": {"2018/01/01"}, "endCalendarDate": {"2019/12/31"}},
+		},
+		{
+			name: "assessment max year only",
+			opt:  WithAssessmentYearRange(0, 2020),
+			want: url.Values{"endCalendarDate": {"2020/12/31"}},
+		},
+		{
+			name: "assessment invalid years",
+			opt:  WithAssessmentYearRange(-1, 0),
+			want: url.Values{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := url.Values{}
+			tt.opt(vals)
+			if !reflect.DeepEqual(vals, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, vals)
+			}
+		})
+	}
+}
+
 func TestWithPage(t *testing.T) {
 	t.Run("valid page", func(t *testing.T) {
 		vals := url.Values{}
@@ -623,6 +1457,85 @@ func TestGetPropertySnapshotValidation(t *testing.T) {
 		}
 	})
 
+	t.Run("with bounding box", func(t *testing.T) {
+		mock.expectedQuery = url.Values{
+			"minLatitude":  {"40.7"},
+			"minLongitude": {"-74.1"},
+			"maxLatitude":  {"40.8"},
+			"maxLongitude": {"-73.9"},
+		}
+		_, err := svc.GetPropertySnapshot(ctx, WithBoundingBox(40.7, -74.1, 40.8, -73.9))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with inverted bounding box latitude", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx, WithBoundingBox(40.8, -74.1, 40.7, -73.9))
+		if err == nil || !strings.Contains(err.Error(), "minLatitude") {
+			t.Errorf("expected inverted latitude error, got %v", err)
+		}
+	})
+
+	t.Run("with inverted bounding box longitude", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx, WithBoundingBox(40.7, -73.9, 40.8, -74.1))
+		if err == nil || !strings.Contains(err.Error(), "minLongitude") {
+			t.Errorf("expected inverted longitude error, got %v", err)
+		}
+	})
+
+	t.Run("with incomplete bounding box", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx, WithString("minLatitude", "40.7"))
+		if !errors.Is(err, ErrMissingParameter) {
+			t.Errorf("expected ErrMissingParameter, got %v", err)
+		}
+	})
+
+	t.Run("with WKT polygon", func(t *testing.T) {
+		mock.expectedQuery = url.Values{"WKTString": {"POLYGON((-74.1 40.7, -73.9 40.7, -73.9 40.8, -74.1 40.7))"}}
+		_, err := svc.GetPropertySnapshot(ctx, WithWKTPolygon(
+			[2]float64{40.7, -74.1},
+			[2]float64{40.7, -73.9},
+			[2]float64{40.8, -73.9},
+		))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with WKT polygon and lat/lon", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx,
+			WithBoundingBoxWKT(40.7, -74.1, 40.8, -73.9),
+			WithLatitudeLongitude(40.7128, -74.0060), WithRadius(5))
+		if err == nil || !strings.Contains(err.Error(), "WKTString cannot be combined with latitude") {
+			t.Errorf("expected conflicting geo error, got %v", err)
+		}
+	})
+
+	t.Run("with WKT polygon and bounding box", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx,
+			WithBoundingBoxWKT(40.7, -74.1, 40.8, -73.9),
+			WithBoundingBox(40.7, -74.1, 40.8, -73.9))
+		if err == nil || !strings.Contains(err.Error(), "WKTString cannot be combined with minLatitude") {
+			t.Errorf("expected conflicting geo error, got %v", err)
+		}
+	})
+
+	t.Run("with valid property type filter", func(t *testing.T) {
+		mock.expectedQuery = url.Values{"postalCode": {"12345"}, "propertytype": {"SFR"}}
+		_, err := svc.GetPropertySnapshot(ctx, WithPostalCode("12345"), WithSnapshotPropertyTypeFilter(" sfr "))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with invalid property type filter", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(ctx, WithPostalCode("12345"), WithSnapshotPropertyTypeFilter("castle"))
+		if err == nil || !strings.Contains(err.Error(), "invalid property type") {
+			t.Errorf("expected invalid property type error, got %v", err)
+		}
+	})
+
 	t.Run("missing required params", func(t *testing.T) {
 		_, err := svc.GetPropertySnapshot(ctx)
 		if err == nil {
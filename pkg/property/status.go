@@ -0,0 +1,23 @@
+package property
+
+// TotalPages returns the number of pages needed to hold Total records at
+// PageSize records per page. It returns 0 when the status is nil or either
+// value is missing or non-positive.
+func (s *Status) TotalPages() int {
+	total, size := s.GetTotal(), s.GetPageSize()
+	if total <= 0 || size <= 0 {
+		return 0
+	}
+	return (total + size - 1) / size
+}
+
+// HasMorePages reports whether pages follow the current one. ATTOM pages are
+// numbered from 1; a missing Page is treated as the first page. It returns false
+// when the status is nil or TotalPages cannot be determined.
+func (s *Status) HasMorePages() bool {
+	page := s.GetPage()
+	if page < 1 {
+		page = 1
+	}
+	return page < s.TotalPages()
+}
@@ -0,0 +1,33 @@
+package property
+
+import "testing"
+
+func TestStatusPagination(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	tests := []struct {
+		status     *Status
+		name       string
+		totalPages int
+		hasMore    bool
+	}{
+		{name: "nil status", status: nil},
+		{name: "empty status", status: &Status{}},
+		{name: "first of three", status: &Status{Total: intPtr(45), Page: intPtr(1), PageSize: intPtr(20)}, totalPages: 3, hasMore: true},
+		{name: "mid list", status: &Status{Total: intPtr(45), Page: intPtr(2), PageSize: intPtr(20)}, totalPages: 3, hasMore: true},
+		{name: "last page", status: &Status{Total: intPtr(45), Page: intPtr(3), PageSize: intPtr(20)}, totalPages: 3},
+		{name: "exact multiple", status: &Status{Total: intPtr(40), Page: intPtr(2), PageSize: intPtr(20)}, totalPages: 2},
+		{name: "missing page", status: &Status{Total: intPtr(30), PageSize: intPtr(10)}, totalPages: 3, hasMore: true},
+		{name: "zero page size", status: &Status{Total: intPtr(30), Page: intPtr(1), PageSize: intPtr(0)}},
+		{name: "no results", status: &Status{Total: intPtr(0), Page: intPtr(1), PageSize: intPtr(10)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.TotalPages(); got != tt.totalPages {
+				t.Errorf("TotalPages() = %d, want %d", got, tt.totalPages)
+			}
+			if got := tt.status.HasMorePages(); got != tt.hasMore {
+				t.Errorf("HasMorePages() = %v, want %v", got, tt.hasMore)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// jsonField is a struct field as seen by encoding/json: its key and its type.
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// checkUnknownFields returns an error naming the first object key in data, at any
+// depth, that has no matching field in t. WithStrictDecoding relies on it because
+// json.Decoder.DisallowUnknownFields does not reach into types that implement
+// their own UnmarshalJSON, such as Assessment and Sale. Keys are matched the way
+// encoding/json matches them: exactly, then case-insensitively.
+func checkUnknownFields(data []byte, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			// Not an object, such as a flat sale amount; the decode has
+			// already accepted it.
+			return nil
+		}
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fields := jsonFields(t)
+		for _, key := range keys {
+			field, ok := lookupJSONField(fields, key)
+			if !ok {
+				return fmt.Errorf("json: unknown field %q", joinJSONPath(path, key))
+			}
+			if err := checkUnknownFields(obj[key], field, joinJSONPath(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// []byte and json.RawMessage hold arbitrary JSON.
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil
+		}
+		for i, item := range items {
+			if err := checkUnknownFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields lists the fields encoding/json decodes into for struct type t,
+// including those promoted from embedded structs.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, jsonFields(embedded)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{name: name, typ: field.Type})
+	}
+	return fields
+}
+
+// lookupJSONField returns the type of the field that key decodes into.
+func lookupJSONField(fields []jsonField, key string) (reflect.Type, bool) {
+	for _, field := range fields {
+		if field.name == key {
+			return field.typ, true
+		}
+	}
+	for _, field := range fields {
+		if strings.EqualFold(field.name, key) {
+			return field.typ, true
+		}
+	}
+	return nil, false
+}
+
+// joinJSONPath appends key to a dotted path such as "property[0].assessment".
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
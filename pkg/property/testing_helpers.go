@@ -41,6 +41,14 @@ func (m *mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	return &http.Response{StatusCode: code, Body: body, Header: make(http.Header)}, nil
 }
 
+// httpClientFunc adapts a function to the client.HTTPClient interface for tests
+// that need to inspect request headers or return custom response headers.
+type httpClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 // diffQuery compares two url.Values and returns a string describing the difference, or "" if equal.
 func diffQuery(expected, actual url.Values) string {
 	if len(expected) != len(actual) {
@@ -0,0 +1,387 @@
+package property
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FlexFloat is a float64 that tolerates the inconsistent encodings ATTOM uses for
+// percentage-style fields. It decodes JSON numbers, quoted numbers such as "87",
+// and percent-suffixed strings such as "87.5%". Empty strings decode to zero, but
+// the models in this package leave a field sent as "" nil (see nilBlankFields).
+type FlexFloat float64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return fmt.Errorf("property: invalid FlexFloat %s: %w", trimmed, err)
+		}
+		return f.UnmarshalText([]byte(s))
+	}
+	v, err := strconv.ParseFloat(string(trimmed), 64)
+	if err != nil {
+		return fmt.Errorf("property: invalid FlexFloat %s: %w", trimmed, err)
+	}
+	*f = FlexFloat(v)
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, which is used when decoding
+// XML. It accepts the same string forms as UnmarshalJSON.
+func (f *FlexFloat) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(string(text)), "%"))
+	if s == "" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("property: invalid FlexFloat %q: %w", text, err)
+	}
+	*f = FlexFloat(v)
+	return nil
+}
+
+// Float64 returns the value as a float64.
+func (f FlexFloat) Float64() float64 {
+	return float64(f)
+}
+
+// FlexInt is an int that tolerates numeric values ATTOM sometimes returns as
+// strings, such as square footage. It decodes JSON numbers, including integral
+// decimals like 1250.0, and quoted numbers such as "1250" or "1,250". Empty
+// strings decode to zero, but model fields sent as "" are left nil. It encodes
+// as a plain JSON number.
+type FlexInt int
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *FlexInt) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return fmt.Errorf("property: invalid FlexInt %s: %w", trimmed, err)
+		}
+		return n.UnmarshalText([]byte(s))
+	}
+	return n.UnmarshalText(trimmed)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, which is used when decoding
+// XML. It accepts the same string forms as UnmarshalJSON.
+func (n *FlexInt) UnmarshalText(text []byte) error {
+	s := strings.ReplaceAll(strings.TrimSpace(string(text)), ",", "")
+	if s == "" {
+		*n = 0
+		return nil
+	}
+	if v, err := strconv.Atoi(s); err == nil {
+		*n = FlexInt(v)
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || math.Abs(f) > 1<<53 {
+		return fmt.Errorf("property: invalid FlexInt %q", text)
+	}
+	*n = FlexInt(f)
+	return nil
+}
+
+// Int returns the value as an int.
+func (n FlexInt) Int() int {
+	return int(n)
+}
+
+// FlexBool is a bool that tolerates the string encodings ATTOM uses for flags.
+// It decodes JSON booleans and the values Y/N, YES/NO, T/F, TRUE/FALSE, and 1/0,
+// quoted or unquoted and case-insensitively. Empty strings decode to false, but
+// model fields sent as "" are left nil so an unknown flag is not read as false.
+type FlexBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	s := string(trimmed)
+	if trimmed[0] == '"' {
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return fmt.Errorf("property: invalid FlexBool %s: %w", trimmed, err)
+		}
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, which is used when decoding
+// XML. It accepts the same string forms as UnmarshalJSON.
+func (b *FlexBool) UnmarshalText(text []byte) error {
+	switch strings.ToUpper(strings.TrimSpace(string(text))) {
+	case "Y", "YES", "T", "TRUE", "1":
+		*b = true
+	case "N", "NO", "F", "FALSE", "0", "":
+		*b = false
+	default:
+		return fmt.Errorf("property: invalid FlexBool %q", text)
+	}
+	return nil
+}
+
+// Bool returns the value as a bool.
+func (b FlexBool) Bool() bool {
+	return bool(b)
+}
+
+// Money is a currency amount stored as a whole number of cents, so amounts can
+// be summed without floating-point drift. It decodes JSON numbers and numeric
+// strings, with optional thousands separators and a leading "$", rounding to the
+// nearest cent. Empty strings decode to zero. It encodes as a JSON number in
+// dollars.
+type Money int64
+
+// MoneyFromFloat converts a dollar amount to Money, rounding to the nearest cent.
+// Use it for fields still modeled as float64, such as Sale.Amount.
+func MoneyFromFloat(dollars float64) Money {
+	return Money(math.Round(dollars * 100))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	s := string(trimmed)
+	if trimmed[0] == '"' {
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return fmt.Errorf("property: invalid Money %s: %w", trimmed, err)
+		}
+	}
+	return m.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, which is used when decoding
+// XML. It accepts the same string forms as UnmarshalJSON.
+func (m *Money) UnmarshalText(text []byte) error {
+	s := strings.ReplaceAll(strings.TrimSpace(string(text)), ",", "")
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "$")
+	if s == "" {
+		*m = 0
+		return nil
+	}
+	if strings.ContainsAny(s, "eE") {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("property: invalid Money %q: %w", text, err)
+		}
+		*m = MoneyFromFloat(v)
+	} else {
+		cents, err := parseCents(s)
+		if err != nil {
+			return fmt.Errorf("property: invalid Money %q: %w", text, err)
+		}
+		*m = Money(cents)
+	}
+	if negative {
+		*m = -*m
+	}
+	return nil
+}
+
+// parseCents parses an unsigned decimal dollar amount into cents, rounding any
+// digits beyond the cent half up.
+func parseCents(s string) (int64, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, strconv.ErrSyntax
+	}
+	var dollars int64
+	if whole != "" {
+		var err error
+		if dollars, err = strconv.ParseInt(whole, 10, 64); err != nil || dollars < 0 {
+			return 0, strconv.ErrSyntax
+		}
+	}
+	for _, r := range frac {
+		if r < '0' || r > '9' {
+			return 0, strconv.ErrSyntax
+		}
+	}
+	padded := frac + "00"
+	cents := int64(padded[0]-'0')*10 + int64(padded[1]-'0')
+	if len(frac) > 2 && frac[2] >= '5' {
+		cents++
+	}
+	if dollars > (math.MaxInt64-cents)/100 {
+		return 0, strconv.ErrRange
+	}
+	return dollars*100 + cents, nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the amount in dollars.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Cents returns the amount in cents.
+func (m Money) Cents() int64 {
+	return int64(m)
+}
+
+// Float64 returns the amount in dollars.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// String formats the amount in dollars with two decimal places, such as
+// "123456.78" or "-0.05".
+func (m Money) String() string {
+	cents := int64(m)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// flexTypes are the element types whose blank-string values nilBlankFields
+// clears.
+var flexTypes = map[reflect.Type]bool{
+	reflect.TypeOf(FlexFloat(0)):    true,
+	reflect.TypeOf(FlexInt(0)):      true,
+	reflect.TypeOf(FlexBool(false)): true,
+	reflect.TypeOf(Money(0)):        true,
+}
+
+// nilBlankFields sets the *FlexFloat, *FlexInt, *FlexBool, and *Money fields of
+// the struct v points to back to nil when data, the JSON object v was decoded
+// from, holds them as blank strings. encoding/json allocates a field's pointer
+// before calling its UnmarshalJSON, so without this an unknown value sent as ""
+// would decode to a non-nil zero.
+func nilBlankFields(data []byte, v interface{}) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// Non-object input has already been rejected by the caller's decode.
+		return
+	}
+	rv := reflect.ValueOf(v).Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Type.Kind() != reflect.Pointer || !flexTypes[field.Type.Elem()] {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		value, ok := raw[name]
+		if !ok {
+			// encoding/json falls back to a case-insensitive key match.
+			for key, candidate := range raw {
+				if strings.EqualFold(key, name) {
+					value, ok = candidate, true
+					break
+				}
+			}
+		}
+		if ok && isBlankJSONString(value) {
+			rv.Field(i).SetZero()
+		}
+	}
+}
+
+// isBlankJSONString reports whether value is a JSON string holding only
+// whitespace.
+func isBlankJSONString(value json.RawMessage) bool {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 || value[0] != '"' {
+		return false
+	}
+	var s string
+	return json.Unmarshal(value, &s) == nil && strings.TrimSpace(s) == ""
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving counts sent as "" nil.
+func (r *Rooms) UnmarshalJSON(data []byte) error {
+	type plain Rooms
+	if err := json.Unmarshal(data, (*plain)(r)); err != nil {
+		return err
+	}
+	nilBlankFields(data, r)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving areas sent as "" nil.
+func (b *BuildingArea) UnmarshalJSON(data []byte) error {
+	type plain BuildingArea
+	if err := json.Unmarshal(data, (*plain)(b)); err != nil {
+		return err
+	}
+	nilBlankFields(data, b)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving values sent as "" nil.
+func (a *Assessment) UnmarshalJSON(data []byte) error {
+	type plain Assessment
+	if err := json.Unmarshal(data, (*plain)(a)); err != nil {
+		return err
+	}
+	nilBlankFields(data, a)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving scores sent as "" nil.
+func (a *AVM) UnmarshalJSON(data []byte) error {
+	type plain AVM
+	if err := json.Unmarshal(data, (*plain)(a)); err != nil {
+		return err
+	}
+	nilBlankFields(data, a)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving a rate sent as "" nil.
+func (m *Mortgage) UnmarshalJSON(data []byte) error {
+	type plain Mortgage
+	if err := json.Unmarshal(data, (*plain)(m)); err != nil {
+		return err
+	}
+	nilBlankFields(data, m)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving a delinquency flag sent as
+// "" nil.
+func (t *Tax) UnmarshalJSON(data []byte) error {
+	type plain Tax
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+	nilBlankFields(data, t)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, leaving amounts sent as "" nil.
+func (p *PreforeclosureDetail) UnmarshalJSON(data []byte) error {
+	type plain PreforeclosureDetail
+	if err := json.Unmarshal(data, (*plain)(p)); err != nil {
+		return err
+	}
+	nilBlankFields(data, p)
+	return nil
+}
@@ -0,0 +1,340 @@
+package property
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFlexFloatUnmarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  float64
+	}{
+		{name: "integer", input: `{"percentile":87}`, want: 87},
+		{name: "decimal", input: `{"percentile":87.25}`, want: 87.25},
+		{name: "quoted number", input: `{"percentile":"87.5"}`, want: 87.5},
+		{name: "percent suffix", input: `{"percentile":"87.5%"}`, want: 87.5},
+		{name: "percent suffix with spaces", input: `{"percentile":" 42 % "}`, want: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var avm AVM
+			if err := json.Unmarshal([]byte(tt.input), &avm); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if avm.Percentile == nil {
+				t.Fatalf("expected percentile to be set")
+			}
+			if got := avm.Percentile.Float64(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("null leaves pointer nil", func(t *testing.T) {
+		var avm AVM
+		if err := json.Unmarshal([]byte(`{"score":null}`), &avm); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if avm.Score != nil {
+			t.Errorf("expected nil score, got %v", *avm.Score)
+		}
+	})
+
+	t.Run("invalid string", func(t *testing.T) {
+		var avm AVM
+		if err := json.Unmarshal([]byte(`{"score":"high"}`), &avm); err == nil {
+			t.Errorf("expected error for non-numeric score")
+		}
+	})
+
+	t.Run("marshals as number", func(t *testing.T) {
+		v := FlexFloat(12.5)
+		data, err := json.Marshal(AVM{Score: &v})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != `{"score":12.5}` {
+			t.Errorf("unexpected JSON: %s", data)
+		}
+	})
+}
+
+func TestFlexBoolUnmarshal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: `true`, want: true},
+		{input: `false`, want: false},
+		{input: `1`, want: true},
+		{input: `0`, want: false},
+		{input: `"Y"`, want: true},
+		{input: `"N"`, want: false},
+		{input: `"y"`, want: true},
+		{input: `"Yes"`, want: true},
+		{input: `"no"`, want: false},
+		{input: `"true"`, want: true},
+		{input: `"FALSE"`, want: false},
+		{input: `"T"`, want: true},
+		{input: `"F"`, want: false},
+		{input: `"1"`, want: true},
+		{input: `"0"`, want: false},
+		{input: `" Y "`, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var tax Tax
+			if err := json.Unmarshal([]byte(`{"delinquent":`+tt.input+`}`), &tax); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tax.Delinquent == nil {
+				t.Fatalf("expected delinquent to be set")
+			}
+			if got := tax.Delinquent.Bool(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("null leaves pointer nil", func(t *testing.T) {
+		var tax Tax
+		if err := json.Unmarshal([]byte(`{"delinquent":null}`), &tax); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tax.Delinquent != nil {
+			t.Errorf("expected nil delinquent, got %v", *tax.Delinquent)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		for _, input := range []string{`"maybe"`, `2`} {
+			var tax Tax
+			if err := json.Unmarshal([]byte(`{"delinquent":`+input+`}`), &tax); err == nil {
+				t.Errorf("expected error for %s", input)
+			}
+		}
+	})
+
+	t.Run("marshals as bool", func(t *testing.T) {
+		v := FlexBool(true)
+		data, err := json.Marshal(Tax{Delinquent: &v})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != `{"delinquent":true}` {
+			t.Errorf("unexpected JSON: %s", data)
+		}
+	})
+}
+
+func TestFlexIntUnmarshal(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "number", input: `{"livingSqFt":1250}`, want: 1250},
+		{name: "integral decimal", input: `{"livingSqFt":1250.0}`, want: 1250},
+		{name: "quoted number", input: `{"livingSqFt":"1250"}`, want: 1250},
+		{name: "thousands separator", input: `{"livingSqFt":"1,250"}`, want: 1250},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var area BuildingArea
+			if err := json.Unmarshal([]byte(tt.input), &area); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if area.LivingSquareFeet == nil || area.LivingSquareFeet.Int() != tt.want {
+				t.Errorf("expected %d, got %v", tt.want, area.LivingSquareFeet)
+			}
+		})
+	}
+
+	for _, input := range []string{`{"livingSqFt":"large"}`, `{"livingSqFt":12.5}`} {
+		var area BuildingArea
+		if err := json.Unmarshal([]byte(input), &area); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+}
+
+func TestFlexTypesDecodeEmptyStringToZero(t *testing.T) {
+	var f FlexFloat = 1
+	var n FlexInt = 1
+	var b FlexBool = true
+	for _, target := range []json.Unmarshaler{&f, &n, &b} {
+		if err := target.UnmarshalJSON([]byte(`""`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if f != 0 || n != 0 || b {
+		t.Errorf("expected zero values, got %v %v %v", f, n, b)
+	}
+}
+
+func TestBlankStringFieldsDecodeToNil(t *testing.T) {
+	var prop Property
+	body := `{
+		"building":{"rooms":{"bathsTotal":""},"area":{"livingSqFt":"","totalSqFt":"1,800"}},
+		"assessment":{"assdTtlValue":"","mktTtlValue":"500000","taxAmt":" "},
+		"avm":{"percentile":"","score":"87"},
+		"mortgage":[{"interestRate":""}],
+		"tax":{"delinquent":""}}`
+	if err := json.Unmarshal([]byte(body), &prop); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prop.Building.Rooms.BathsTotal != nil {
+		t.Errorf("expected nil bathsTotal, got %v", *prop.Building.Rooms.BathsTotal)
+	}
+	if prop.Building.Area.LivingSquareFeet != nil {
+		t.Errorf("expected nil livingSqFt, got %v", *prop.Building.Area.LivingSquareFeet)
+	}
+	if prop.Building.Area.GetTotalSquareFeet() != 1800 {
+		t.Errorf("expected totalSqFt 1800, got %v", prop.Building.Area.TotalSquareFeet)
+	}
+	if prop.Assessment.AssessedTotalValue != nil || prop.Assessment.TaxAmount != nil {
+		t.Errorf("expected nil assessed value and tax amount, got %+v", prop.Assessment)
+	}
+	if prop.Assessment.GetMarketTotalValue() != 500000 {
+		t.Errorf("expected market value 500000, got %v", prop.Assessment.MarketTotalValue)
+	}
+	if ratio, ok := prop.Assessment.AssessmentRatio(); ok {
+		t.Errorf("expected ok=false for unknown assessed value, got %v", ratio)
+	}
+	if prop.AVM.Percentile != nil || prop.AVM.GetScore() != 87 {
+		t.Errorf("unexpected AVM %+v", prop.AVM)
+	}
+	if prop.Mortgage[0].InterestRate != nil {
+		t.Errorf("expected nil interestRate, got %v", *prop.Mortgage[0].InterestRate)
+	}
+	if prop.Tax.Delinquent != nil {
+		t.Errorf("expected nil delinquent, got %v", *prop.Tax.Delinquent)
+	}
+
+	var detail PreforeclosureDetail
+	if err := json.Unmarshal([]byte(`{"amount":"","openingBid":"125000"}`), &detail); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if detail.Amount != nil || detail.GetOpeningBid() != 125000 {
+		t.Errorf("unexpected preforeclosure detail %+v", detail)
+	}
+}
+
+func TestFlexNumbersDecodeStringsAndNumbersAlike(t *testing.T) {
+	var fromNumbers, fromStrings Assessment
+	if err := json.Unmarshal([]byte(`{"assdTtlValue":125000,"mktTtlValue":500000.5,"taxAmt":3125.75}`), &fromNumbers); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"assdTtlValue":"125000","mktTtlValue":"500000.5","taxAmt":"3125.75"}`), &fromStrings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fromNumbers.GetAssessedTotalValue() != 125000 || fromNumbers.GetMarketTotalValue() != 500000.5 || fromNumbers.GetTaxAmount() != 3125.75 {
+		t.Errorf("unexpected numeric decode: %+v", fromNumbers)
+	}
+	if !reflect.DeepEqual(fromNumbers, fromStrings) {
+		t.Errorf("string and number encodings decoded differently")
+	}
+
+	out, err := json.Marshal(&fromStrings)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if want := `{"assdTtlValue":125000,"mktTtlValue":500000.5,"taxAmt":3125.75}`; string(out) != want {
+		t.Errorf("expected numeric output %s, got %s", want, out)
+	}
+}
+
+func TestMoneyUnmarshal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{input: `"123456.78"`, want: 12345678},
+		{input: `123456.78`, want: 12345678},
+		{input: `250000`, want: 25000000},
+		{input: `"$1,250,000.50"`, want: 125000050},
+		{input: `"0.1"`, want: 10},
+		{input: `".05"`, want: 5},
+		{input: `19.999`, want: 2000},
+		{input: `19.994`, want: 1999},
+		{input: `-42.10`, want: -4210},
+		{input: `1.5e3`, want: 150000},
+		{input: `""`, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var m Money
+			if err := json.Unmarshal([]byte(tt.input), &m); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if m.Cents() != tt.want {
+				t.Errorf("expected %d cents, got %d", tt.want, m.Cents())
+			}
+		})
+	}
+
+	t.Run("invalid value", func(t *testing.T) {
+		for _, input := range []string{`"abc"`, `"12.3x"`, `"."`, `true`} {
+			var m Money
+			if err := json.Unmarshal([]byte(input), &m); err == nil {
+				t.Errorf("expected error for %s", input)
+			}
+		}
+	})
+
+	t.Run("null leaves value", func(t *testing.T) {
+		m := Money(7)
+		if err := json.Unmarshal([]byte(`null`), &m); err != nil || m != 7 {
+			t.Errorf("expected unchanged value, got %d (err %v)", m, err)
+		}
+	})
+}
+
+func TestMoneyFormatting(t *testing.T) {
+	m := Money(12345678)
+	if m.String() != "123456.78" || m.Float64() != 123456.78 {
+		t.Errorf("unexpected formatting %s / %v", m, m.Float64())
+	}
+	if got := Money(-5).String(); got != "-0.05" {
+		t.Errorf("expected -0.05, got %s", got)
+	}
+	data, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: m})
+	if err != nil || string(data) != `{"amount":123456.78}` {
+		t.Errorf("unexpected JSON %s (err %v)", data, err)
+	}
+}
+
+func TestMoneyConverters(t *testing.T) {
+	if got := MoneyFromFloat(0.1 + 0.2); got != 30 {
+		t.Errorf("expected 30 cents, got %d", got)
+	}
+
+	var sale Sale
+	if err := json.Unmarshal([]byte(`{"amount":{"saleamt":123456.78}}`), &sale); err != nil {
+		t.Fatalf("decode sale: %v", err)
+	}
+	if got, ok := sale.SaleAmountMoney(); !ok || got != 12345678 {
+		t.Errorf("sale: expected 12345678 cents, got %d (ok=%v)", got, ok)
+	}
+	tax := FlexFloat(2100.55)
+	if got, ok := (&Assessment{TaxAmount: &tax}).TaxAmountMoney(); !ok || got != 210055 {
+		t.Errorf("assessment: expected 210055 cents, got %d (ok=%v)", got, ok)
+	}
+	loan := 320000.0
+	if got, ok := (&Mortgage{LoanAmount: &loan}).LoanAmountMoney(); !ok || got != 32000000 {
+		t.Errorf("mortgage: expected 32000000 cents, got %d (ok=%v)", got, ok)
+	}
+	if _, ok := (*Mortgage)(nil).LoanAmountMoney(); ok {
+		t.Errorf("expected ok=false for nil mortgage")
+	}
+	if _, ok := (&Assessment{}).TaxAmountMoney(); ok {
+		t.Errorf("expected ok=false for missing tax amount")
+	}
+}
@@ -0,0 +1,123 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// valueChecks validate individual parameter values independently of the endpoint
+// being called. Each reports at most one problem.
+var valueChecks = []func(url.Values) error{
+	validatePropertyTypeParam,
+	validateOrderByParam,
+	validateCoordinateParams,
+	validatePagingParams,
+}
+
+// ValidateOptions applies opts and checks the resulting parameter values, such as
+// property types, orderby fields, coordinates, and paging, without sending a
+// request. Unlike the per-endpoint validation it reports every problem found,
+// combined with errors.Join. Endpoint-specific requirements such as a property
+// identifier are not checked; enable WithAggregatedValidation on the Service to
+// include them.
+func ValidateOptions(opts ...Option) error {
+	return errors.Join(checkValues(applyOptions(opts))...)
+}
+
+// WithAggregatedValidation makes the service run the ValidateOptions checks in
+// addition to each method's own validation before sending a request, returning
+// every problem found as a single errors.Join error instead of only the first.
+func WithAggregatedValidation(enabled bool) ServiceOption {
+	return func(s *Service) {
+		s.aggregateValidation = enabled
+	}
+}
+
+// checkValues runs valueChecks against values and returns every error.
+func checkValues(values url.Values) []error {
+	var errs []error
+	for _, check := range valueChecks {
+		if err := check(values); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// validateAggregated runs the value checks and validator, returning all distinct
+// errors joined together.
+func validateAggregated(values url.Values, validator func(url.Values) error) error {
+	errs := checkValues(values)
+	if validator != nil {
+		if err := validator(values); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	seen := make(map[string]struct{}, len(errs))
+	distinct := errs[:0]
+	for _, err := range errs {
+		if _, dup := seen[err.Error()]; dup {
+			continue
+		}
+		seen[err.Error()] = struct{}{}
+		distinct = append(distinct, err)
+	}
+	return errors.Join(distinct...)
+}
+
+// validateOrderByParam checks that orderby names a known field, optionally
+// followed by an asc or desc direction as produced by WithSort.
+func validateOrderByParam(values url.Values) error {
+	raw := values.Get("orderby")
+	if raw == "" {
+		return nil
+	}
+	field, direction, _ := strings.Cut(strings.TrimSpace(raw), " ")
+	if err := ValidateOrderBy(field); err != nil {
+		return fmt.Errorf("property: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(direction)) {
+	case "", "asc", "desc":
+		return nil
+	default:
+		return fmt.Errorf("property: invalid orderby direction: %q", direction)
+	}
+}
+
+// validateCoordinateParams checks that latitude and longitude are supplied
+// together and are valid, in-range numbers.
+func validateCoordinateParams(values url.Values) error {
+	latRaw, lonRaw := values.Get("latitude"), values.Get("longitude")
+	if latRaw == "" && lonRaw == "" {
+		return nil
+	}
+	if latRaw == "" || lonRaw == "" {
+		return fmt.Errorf("%w: latitude and longitude must be provided together", ErrMissingParameter)
+	}
+	lat, err := strconv.ParseFloat(latRaw, 64)
+	if err != nil {
+		return fmt.Errorf("property: invalid latitude %q", latRaw)
+	}
+	lon, err := strconv.ParseFloat(lonRaw, 64)
+	if err != nil {
+		return fmt.Errorf("property: invalid longitude %q", lonRaw)
+	}
+	return validateCoordinates(lat, lon)
+}
+
+// validatePagingParams checks that page and pagesize are positive integers.
+func validatePagingParams(values url.Values) error {
+	for _, key := range []string{"page", "pagesize"} {
+		raw := values.Get(key)
+		if raw == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(raw); err != nil || n < 1 {
+			return fmt.Errorf("property: invalid %s %q (must be a positive integer)", key, raw)
+		}
+	}
+	return nil
+}
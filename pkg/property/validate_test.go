@@ -0,0 +1,76 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/my-eq/go-attom/pkg/client"
+)
+
+func TestValidateOptions(t *testing.T) {
+	if err := ValidateOptions(
+		WithPropertyType(PropertyTypeCondominium),
+		WithSort(OrderBySaleAmount, true),
+		WithLatitudeLongitude(39.7, -104.9),
+		WithPage(2),
+		WithPageSize(50),
+	); err != nil {
+		t.Fatalf("expected valid options, got %v", err)
+	}
+
+	err := ValidateOptions(
+		WithPropertyType("CASTLE"),
+		WithOrderBy("price"),
+		WithLatitudeLongitude(95, -104.9),
+		WithString("pagesize", "0"),
+	)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	for _, want := range []string{`"CASTLE"`, `"price"`, "latitude", "pagesize"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %s, got %v", want, err)
+		}
+	}
+
+	err = ValidateOptions(WithString("latitude", "39.7"), WithOrderBy("beds sideways"))
+	if !errors.Is(err, ErrMissingParameter) || !strings.Contains(err.Error(), "sideways") {
+		t.Errorf("expected missing longitude and bad direction, got %v", err)
+	}
+}
+
+func TestWithAggregatedValidation(t *testing.T) {
+	svc := NewService(client.New("test-key", &mockHTTPClient{t: t}), WithAggregatedValidation(true))
+	_, err := svc.GetPropertyDetail(context.Background(), WithPropertyType("CASTLE"), WithOrderBy("price"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, ErrMissingParameter) {
+		t.Errorf("expected missing identifier to be reported, got %v", err)
+	}
+	for _, want := range []string{`"CASTLE"`, `"price"`, "attomid"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %s, got %v", want, err)
+		}
+	}
+
+	t.Run("duplicates collapsed", func(t *testing.T) {
+		_, err := svc.GetPropertySnapshot(context.Background(), WithPostalCode("80202"), WithPropertyType("CASTLE"))
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if n := strings.Count(err.Error(), `"CASTLE"`); n != 1 {
+			t.Errorf("expected property type error once, got %d times: %v", n, err)
+		}
+	})
+
+	t.Run("disabled reports first error only", func(t *testing.T) {
+		svc := NewService(client.New("test-key", &mockHTTPClient{t: t}))
+		_, err := svc.GetPropertyDetail(context.Background(), WithPropertyType("CASTLE"))
+		if err == nil || strings.Contains(err.Error(), "CASTLE") {
+			t.Errorf("expected only the identifier error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package property
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// wktCoordinate formats a coordinate pair in WKT's "longitude latitude" order.
+func wktCoordinate(lat, lon float64) string {
+	return strconv.FormatFloat(lon, 'f', -1, 64) + " " + strconv.FormatFloat(lat, 'f', -1, 64)
+}
+
+// WKTPoint returns a WKT point for the coordinate, e.g. "POINT(-104.99 39.74)".
+// WKT orders coordinates as longitude then latitude, which is what ATTOM expects.
+func WKTPoint(lat, lon float64) string {
+	return "POINT(" + wktCoordinate(lat, lon) + ")"
+}
+
+// WKTPolygon returns a WKT polygon whose exterior ring visits points, each given
+// as {latitude, longitude}. The ring is closed automatically when the last point
+// differs from the first. It returns an empty string when fewer than three
+// points are supplied, since such a ring cannot enclose an area.
+func WKTPolygon(points [][2]float64) string {
+	if len(points) < 3 {
+		return ""
+	}
+	ring := make([]string, 0, len(points)+1)
+	for _, p := range points {
+		ring = append(ring, wktCoordinate(p[0], p[1]))
+	}
+	if points[0] != points[len(points)-1] {
+		ring = append(ring, ring[0])
+	}
+	return "POLYGON((" + strings.Join(ring, ", ") + "))"
+}
+
+// WithWKTPoint sets the WKTString parameter to WKTPoint(lat, lon). The option is
+// a no-op when the coordinates are out of range.
+func WithWKTPoint(lat, lon float64) Option {
+	if validateCoordinates(lat, lon) != nil {
+		return func(url.Values) {}
+	}
+	return WithWKTString(WKTPoint(lat, lon))
+}
+
+// WithWKTPolygon sets the WKTString parameter to WKTPolygon(points). The option
+// is a no-op when fewer than three points are given or any is out of range.
+func WithWKTPolygon(points ...[2]float64) Option {
+	for _, p := range points {
+		if validateCoordinates(p[0], p[1]) != nil {
+			return func(url.Values) {}
+		}
+	}
+	return WithWKTString(WKTPolygon(points))
+}
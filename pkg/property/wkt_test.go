@@ -0,0 +1,50 @@
+package property
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestWKTPoint(t *testing.T) {
+	if got, want := WKTPoint(39.7392, -104.9903), "POINT(-104.9903 39.7392)"; got != want {
+		t.Errorf("WKTPoint() = %q, want %q", got, want)
+	}
+
+	vals := url.Values{}
+	WithWKTPoint(39.7392, -104.9903)(vals)
+	if got := vals.Get("WKTString"); got != "POINT(-104.9903 39.7392)" {
+		t.Errorf("WithWKTPoint set %q", got)
+	}
+	vals = url.Values{}
+	WithWKTPoint(95, 0)(vals)
+	if vals.Has("WKTString") {
+		t.Errorf("expected out-of-range point to be a no-op, got %q", vals.Get("WKTString"))
+	}
+}
+
+func TestWKTPolygon(t *testing.T) {
+	const want = "POLYGON((-105 39, -104 39, -104 40, -105 40, -105 39))"
+	open := [][2]float64{{39, -105}, {39, -104}, {40, -104}, {40, -105}}
+	closed := append(append([][2]float64(nil), open...), open[0])
+
+	if got := WKTPolygon(open); got != want {
+		t.Errorf("WKTPolygon(open) = %q, want %q", got, want)
+	}
+	if got := WKTPolygon(closed); got != want {
+		t.Errorf("WKTPolygon(closed) = %q, want %q", got, want)
+	}
+	if got := WKTPolygon(open[:2]); got != "" {
+		t.Errorf("expected empty string for two points, got %q", got)
+	}
+
+	vals := url.Values{}
+	WithWKTPolygon(open...)(vals)
+	if got := vals.Get("WKTString"); got != want {
+		t.Errorf("WithWKTPolygon set %q, want %q", got, want)
+	}
+	vals = url.Values{}
+	WithWKTPolygon([2]float64{39, -105}, [2]float64{39, -190}, [2]float64{40, -104})(vals)
+	if vals.Has("WKTString") {
+		t.Errorf("expected out-of-range polygon to be a no-op, got %q", vals.Get("WKTString"))
+	}
+}